@@ -7,4 +7,16 @@ data "salesforce_profile" "chatter_free" {
 
 output "profile_id" {
   value = data.salesforce_profile.chatter_free.id
-}
\ No newline at end of file
+}
+
+# clone a standard profile's license and selected permissions into a custom profile
+data "salesforce_profile" "standard" {
+  name             = "Standard User"
+  permission_names = ["ApiEnabled", "ExportReport", "RunReports"]
+}
+
+resource "salesforce_profile" "clone" {
+  name            = "Standard User Clone"
+  user_license_id = data.salesforce_profile.standard.user_license_id
+  permissions     = data.salesforce_profile.standard.permissions
+}
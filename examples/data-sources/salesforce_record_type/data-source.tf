@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_record_type" "partner" {
+  sobject_type   = "Account"
+  developer_name = "Partner"
+}
+
+resource "salesforce_account" "example" {
+  name           = "Example Partner"
+  record_type_id = data.salesforce_record_type.partner.id
+}
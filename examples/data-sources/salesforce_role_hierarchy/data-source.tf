@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_user_role" "ceo" {
+  developer_name = "CEO"
+}
+
+data "salesforce_role_hierarchy" "below_ceo" {
+  root_role_id = data.salesforce_user_role.ceo.id
+}
+
+output "role_tree" {
+  value = [for role in data.salesforce_role_hierarchy.below_ceo.roles : "${join("", [for i in range(role.depth) : "  "])}${role.path}"]
+}
@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_sobject" "invoice" {
+  object_type       = "Invoice__c"
+  external_id_field = "Invoice_Number__c"
+  external_id       = "INV-0001"
+  field_names       = ["Id", "Name", "Amount__c", "Paid__c"]
+}
+
+output "invoice_amount" {
+  value = data.salesforce_sobject.invoice.fields.Amount__c
+}
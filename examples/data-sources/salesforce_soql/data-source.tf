@@ -0,0 +1,14 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_soql" "technology_accounts" {
+  query = "SELECT Id, Name, Owner.Email FROM Account WHERE Industry = :industry AND Type IN :types"
+  parameters = {
+    industry = "Technology"
+    types    = ["Customer", "Partner"]
+  }
+}
+
+output "account_names" {
+  value = [for record in data.salesforce_soql.technology_accounts.records : record.Name]
+}
@@ -0,0 +1,19 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_user" "integration" {
+  username = "integration@example.com"
+}
+
+data "salesforce_profile" "standard" {
+  name = "Standard User"
+}
+
+resource "salesforce_user" "example" {
+  alias      = "example"
+  email      = "user@example.com"
+  last_name  = "example"
+  username   = "user@example.com"
+  profile_id = data.salesforce_profile.standard.id
+  manager_id = data.salesforce_user.integration.id
+}
@@ -8,4 +8,10 @@ data "salesforce_user_license" "fdc" {
 
 output "user_license_id" {
   value = data.salesforce_user_license.fdc.id
-}
\ No newline at end of file
+}
+
+# keys not known to the provider, such as ones specific to your org, need strict disabled
+data "salesforce_user_license" "org_specific" {
+  license_definition_key = "PID_Org_Specific_License"
+  strict                 = false
+}
@@ -0,0 +1,31 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_user_licenses" "all" {
+}
+
+locals {
+  salesforce_seats = one([for l in data.salesforce_user_licenses.all.licenses : l if l.license_definition_key == "SFDC"])
+  new_users        = toset(["alice@example.com", "bob@example.com"])
+}
+
+data "salesforce_profile" "standard" {
+  name = "Standard User"
+}
+
+resource "salesforce_user" "team" {
+  for_each = local.new_users
+
+  alias      = substr(split("@", each.key)[0], 0, 8)
+  email      = each.key
+  last_name  = split("@", each.key)[0]
+  username   = each.key
+  profile_id = data.salesforce_profile.standard.id
+
+  lifecycle {
+    precondition {
+      condition     = local.salesforce_seats.available_licenses >= length(local.new_users)
+      error_message = "Not enough Salesforce licenses available for the new users."
+    }
+  }
+}
@@ -0,0 +1,12 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_user_role" "ceo" {
+  developer_name = "CEO"
+}
+
+resource "salesforce_user_role" "vp_sales" {
+  name           = "VP, Sales"
+  developer_name = "VP_Sales"
+  parent_role_id = data.salesforce_user_role.ceo.id
+}
@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_profile" "standard" {
+  name = "Standard User"
+}
+
+data "salesforce_users" "active_standard" {
+  profile_id   = data.salesforce_profile.standard.id
+  is_active    = true
+  email_domain = "example.com"
+}
+
+output "usernames" {
+  value = data.salesforce_users.active_standard.users[*].username
+}
+
+data "salesforce_user_role" "vp_sales" {
+  developer_name = "VP_Sales"
+}
+
+# the users of the VP Sales role and of every role below it
+data "salesforce_users" "sales_organization" {
+  user_role_id              = data.salesforce_user_role.vp_sales.id
+  include_subordinate_roles = true
+  is_active                 = true
+}
@@ -0,0 +1,6 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# Accounts can be imported by ID or by external ID field and value separated by a slash.
+terraform import salesforce_account.example 001B0000000abc1AAA
+terraform import salesforce_account.example ERP_Id__c/ERP-1001
\ No newline at end of file
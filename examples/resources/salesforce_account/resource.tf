@@ -0,0 +1,40 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_account" "parent" {
+  name = "Example Holdings"
+}
+
+resource "salesforce_account" "example" {
+  name     = "Example Company"
+  type     = "Customer"
+  industry = "Technology"
+  phone    = "555-123-4567"
+  website  = "https://example.com"
+
+  description         = "Enterprise customer"
+  rating              = "Hot"
+  ownership           = "Public"
+  annual_revenue      = 12500000
+  number_of_employees = 480
+  parent_id           = salesforce_account.parent.id
+
+  billing_address = {
+    street      = "415 Mission Street"
+    city        = "San Francisco"
+    state       = "CA"
+    postal_code = "94105"
+    country     = "USA"
+  }
+
+  custom_fields = {
+    Tier__c         = "Gold"
+    Seats__c        = "250"
+    Renewal_Date__c = "2025-01-31"
+    Strategic__c    = "true"
+  }
+
+  # adopt the account if it was already created by an integration
+  external_id_field = "ERP_Id__c"
+  external_id       = "ERP-1001"
+}
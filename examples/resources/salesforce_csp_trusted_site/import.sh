@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the name of the trusted site.
+terraform import salesforce_csp_trusted_site.map_tiles Map_Tiles
@@ -0,0 +1,15 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_csp_trusted_site" "map_tiles" {
+  name        = "Map_Tiles"
+  url         = "https://*.tiles.example.com"
+  context     = "LEX"
+  description = "Map tiles shown on the account page"
+
+  # only images and data are loaded from the site
+  font_src  = false
+  frame_src = false
+  media_src = false
+  style_src = false
+}
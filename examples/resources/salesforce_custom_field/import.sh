@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the full name of the field, the object and field API names separated by a dot.
+terraform import salesforce_custom_field.amount Invoice__c.Amount__c
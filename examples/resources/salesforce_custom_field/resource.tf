@@ -0,0 +1,57 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_custom_field" "amount" {
+  object    = "Invoice__c"
+  api_name  = "Amount__c"
+  label     = "Amount"
+  type      = "Currency"
+  precision = 16
+  scale     = 2
+  required  = true
+}
+
+resource "salesforce_custom_field" "status" {
+  object   = "Invoice__c"
+  api_name = "Status__c"
+  label    = "Status"
+  type     = "Picklist"
+  value_set = {
+    restricted = true
+    values = [
+      { value = "Draft", default = true },
+      { value = "Sent" },
+      { value = "Paid" },
+    ]
+  }
+}
+
+resource "salesforce_custom_field" "account" {
+  object            = "Invoice__c"
+  api_name          = "Account__c"
+  label             = "Account"
+  type              = "MasterDetail"
+  reference_to      = "Account"
+  relationship_name = "Invoices"
+}
+
+resource "salesforce_custom_field" "amount_with_tax" {
+  object              = "Invoice__c"
+  api_name            = "Amount_With_Tax__c"
+  label               = "Amount With Tax"
+  type                = "Formula"
+  formula             = "Amount__c * 1.2"
+  formula_return_type = "Currency"
+  precision           = 18
+  scale               = 2
+}
+
+resource "salesforce_custom_field" "invoiced" {
+  object              = "Account"
+  api_name            = "Total_Invoiced__c"
+  label               = "Total Invoiced"
+  type                = "Summary"
+  summary_foreign_key = "Invoice__c.Account__c"
+  summary_operation   = "sum"
+  summarized_field    = "Invoice__c.Amount__c"
+}
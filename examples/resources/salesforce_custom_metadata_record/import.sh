@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the custom metadata type, with or without its __mdt suffix, and record name separated by a dot.
+terraform import salesforce_custom_metadata_record.checkout_v2 Feature_Toggle.Checkout_V2
@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_custom_metadata_record" "checkout_v2" {
+  type  = "Feature_Toggle__mdt"
+  name  = "Checkout_V2"
+  label = "Checkout V2"
+  values = {
+    Enabled__c         = "true"
+    Rollout_Percent__c = "25"
+    Owner_Team__c      = "Payments"
+  }
+}
@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the API name of the object.
+terraform import salesforce_custom_object.invoice Invoice__c
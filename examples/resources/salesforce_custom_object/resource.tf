@@ -0,0 +1,20 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_custom_object" "invoice" {
+  api_name     = "Invoice__c"
+  label        = "Invoice"
+  plural_label = "Invoices"
+  description  = "Invoices sent to accounts"
+
+  name_field = {
+    label          = "Invoice Number"
+    type           = "AutoNumber"
+    display_format = "INV-{0000}"
+  }
+
+  sharing_model  = "Private"
+  enable_history = true
+  enable_reports = true
+  enable_search  = true
+}
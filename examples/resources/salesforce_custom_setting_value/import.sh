@@ -0,0 +1,7 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the custom setting followed by setup_owner_id and the ID of the org, profile or user
+# for hierarchy settings, or by name and the name of the row for list settings.
+terraform import salesforce_custom_setting_value.support App_Config__c/setup_owner_id/00e5e000000Abcd
+terraform import salesforce_custom_setting_value.us Country_Code__c/name/US
@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_profile" "support" {
+  name = "Custom: Support Profile"
+}
+
+# the profile level row of a hierarchy custom setting
+resource "salesforce_custom_setting_value" "support" {
+  setting        = "App_Config__c"
+  setup_owner_id = data.salesforce_profile.support.id
+  values = {
+    Endpoint__c = "https://support.example.com"
+    Timeout__c  = "30"
+    Debug__c    = "true"
+  }
+}
+
+# a row of a list custom setting
+resource "salesforce_custom_setting_value" "us" {
+  setting = "Country_Code__c"
+  name    = "US"
+  values = {
+    Dialing_Code__c = "+1"
+  }
+}
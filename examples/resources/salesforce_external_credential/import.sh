@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the name of the external credential, the credentials of principals aren't imported.
+terraform import salesforce_external_credential.payments Payments
@@ -0,0 +1,28 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+variable "payments_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "salesforce_external_credential" "payments" {
+  name                    = "Payments"
+  label                   = "Payments"
+  authentication_protocol = "Custom"
+
+  parameters = [
+    { name = "Authorization", type = "AuthHeader", value = "Bearer {!$Credential.Payments.ApiKey}" },
+  ]
+
+  principals = [
+    {
+      name = "Integration"
+      type = "NamedPrincipal"
+      # written to Salesforce but never read back
+      credentials = {
+        ApiKey = var.payments_api_key
+      }
+    },
+  ]
+}
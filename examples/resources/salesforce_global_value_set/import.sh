@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the API name of the value set.
+terraform import salesforce_global_value_set.tier Customer_Tier
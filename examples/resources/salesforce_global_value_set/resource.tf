@@ -0,0 +1,17 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_global_value_set" "tier" {
+  name          = "Customer_Tier"
+  label         = "Customer Tier"
+  description   = "Tiers shared by the account and opportunity tier fields"
+  default_value = "Silver"
+  values = [
+    { value = "Gold", label = "Gold Tier" },
+    { value = "Silver" },
+    { value = "Bronze", active = false },
+  ]
+
+  # the removed values still set on records of these fields are reported when planning
+  used_by_fields = ["Account.Tier__c", "Opportunity.Tier__c"]
+}
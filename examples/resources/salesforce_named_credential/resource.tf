@@ -0,0 +1,9 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_named_credential" "payments" {
+  name                = "Payments"
+  label               = "Payments"
+  url                 = "https://api.payments.example.com/v1"
+  external_credential = salesforce_external_credential.payments.name
+}
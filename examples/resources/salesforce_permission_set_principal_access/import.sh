@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the permission set ID, the external credential and the principal separated by slashes.
+terraform import salesforce_permission_set_principal_access.payments 0PS5e000001AbCdGAK/Payments/Integration
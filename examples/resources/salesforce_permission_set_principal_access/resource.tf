@@ -0,0 +1,8 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_permission_set_principal_access" "payments" {
+  permission_set_id   = "0PS5e000001AbCdGAK"
+  external_credential = salesforce_external_credential.payments.name
+  principal           = "Integration"
+}
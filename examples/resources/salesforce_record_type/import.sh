@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the full name of the record type, the object API name and developer name separated by a dot.
+terraform import salesforce_record_type.partner Lead.Partner
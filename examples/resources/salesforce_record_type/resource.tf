@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_record_type" "partner" {
+  object           = "Lead"
+  developer_name   = "Partner"
+  label            = "Partner"
+  description      = "Leads referred by partners"
+  business_process = "Partner Leads"
+  picklist_values = [
+    {
+      picklist      = "LeadSource"
+      values        = ["Partner Referral", "Web"]
+      default_value = "Partner Referral"
+    },
+  ]
+}
+
+resource "salesforce_sobject" "lead" {
+  object_type = "Lead"
+  fields = {
+    LastName     = "Example"
+    Company      = "Example Partner"
+    RecordTypeId = salesforce_record_type.partner.record_type_id
+  }
+}
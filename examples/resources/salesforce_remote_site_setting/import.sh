@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the name of the remote site.
+terraform import salesforce_remote_site_setting.payments Payments_API
@@ -0,0 +1,8 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_remote_site_setting" "payments" {
+  name        = "Payments_API"
+  url         = "https://api.payments.example.com"
+  description = "Callouts to the payments provider"
+}
@@ -0,0 +1,8 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the object type and record ID separated by a slash, or the
+# object type, external ID field and external ID value separated by slashes.
+# Fields will not import to state, run a subsequent apply to sync those in config.
+terraform import salesforce_sobject.example Invoice__c/a01B0000000abc1AAA
+terraform import salesforce_sobject.example Invoice__c/Invoice_Number__c/INV-0001
\ No newline at end of file
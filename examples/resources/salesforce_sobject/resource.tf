@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+data "salesforce_account" "acme" {
+  name = "Acme"
+}
+
+resource "salesforce_sobject" "invoice" {
+  object_type = "Invoice__c"
+  fields = {
+    Name        = "INV-0001"
+    Amount__c   = 1250.50
+    Paid__c     = false
+    Due_Date__c = "2024-06-30"
+    Status__c   = "Draft"
+    Account__c  = data.salesforce_account.acme.id
+  }
+}
@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the name of the standard value set.
+terraform import salesforce_standard_value_set.lead_source LeadSource
@@ -0,0 +1,13 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_standard_value_set" "lead_source" {
+  name          = "LeadSource"
+  default_value = "Web"
+  values = [
+    { value = "Web" },
+    { value = "Phone Inquiry" },
+    { value = "Partner Referral" },
+    { value = "Trade Show", label = "Event" },
+  ]
+}
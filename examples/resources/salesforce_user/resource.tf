@@ -22,4 +22,20 @@ resource "salesforce_user" "example" {
   time_zone_sid_key   = "America/Chicago"
   locale_sid_key      = "en_US"
   reset_password      = true
+
+  first_name            = "Ada"
+  title                 = "Chief Executive Officer"
+  department            = "Executive"
+  company_name          = "Example Inc."
+  federation_identifier = "ada@idp.example.com"
+  phone                 = "555-123-4567"
+  employee_number       = "E-0001"
+
+  address = {
+    city    = "Chicago"
+    state   = "IL"
+    country = "USA"
+  }
+
+  user_permissions_marketing_user = true
 }
\ No newline at end of file
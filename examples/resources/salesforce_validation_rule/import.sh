@@ -0,0 +1,5 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# The import identifier is the full name of the rule, the object API name and rule name separated by a dot.
+terraform import salesforce_validation_rule.amount_positive Invoice__c.Amount_Positive
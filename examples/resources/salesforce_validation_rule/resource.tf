@@ -0,0 +1,16 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "salesforce_validation_rule" "amount_positive" {
+  object              = "Invoice__c"
+  name                = "Amount_Positive"
+  description         = "Paid invoices need a positive amount"
+  formula             = <<-EOT
+    AND(
+      ISPICKVAL(Status__c, "Paid"),
+      Amount__c <= 0
+    )
+  EOT
+  error_message       = "A paid invoice needs a positive amount."
+  error_display_field = "Amount__c"
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metadata is a client for the CRUD calls of the Salesforce SOAP Metadata API,
+// used for setup components such as custom objects and fields that the REST data API can't manage.
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	namespace = "http://soap.sforce.com/2006/04/metadata"
+
+	// the CRUD calls accept at most 10 components per call
+	maxComponents = 10
+)
+
+type Client struct {
+	InstanceUrl string
+	AccessToken string
+	// ApiVersion is in the REST format, with or without the leading v, such as v53.0
+	ApiVersion string
+	HTTPClient *http.Client
+}
+
+func New(instanceUrl, accessToken, apiVersion string) *Client {
+	return &Client{
+		InstanceUrl: strings.TrimSuffix(instanceUrl, "/"),
+		AccessToken: accessToken,
+		ApiVersion:  apiVersion,
+		HTTPClient:  http.DefaultClient,
+	}
+}
+
+func (c *Client) endpoint() string {
+	return fmt.Sprintf("%s/services/Soap/m/%s", c.InstanceUrl, strings.TrimPrefix(c.ApiVersion, "v"))
+}
+
+// Fault is a SOAP fault, returned for errors of the whole call such as an invalid session
+type Fault struct {
+	Code             string `xml:"faultcode"`
+	String           string `xml:"faultstring"`
+	ExceptionCode    string `xml:"detail>UnexpectedErrorFault>exceptionCode"`
+	ExceptionMessage string `xml:"detail>UnexpectedErrorFault>exceptionMessage"`
+}
+
+func (f *Fault) Error() string {
+	if f.String != "" {
+		return f.String
+	}
+	return strings.TrimPrefix(f.Code, "sf:")
+}
+
+type envelope struct {
+	Body struct {
+		Fault   *Fault `xml:"Fault"`
+		Content []byte `xml:",innerxml"`
+	} `xml:"Body"`
+}
+
+// call posts request in a SOAP envelope and decodes the body of the response into response
+func (c *Client) call(ctx context.Context, action string, request, response interface{}) error {
+	body, err := xml.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("Error encoding %s request: %v", action, err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns="` + namespace + `">`)
+	buf.WriteString(`<soapenv:Header><SessionHeader><sessionId>`)
+	if err := xml.EscapeText(&buf, []byte(c.AccessToken)); err != nil {
+		return err
+	}
+	buf.WriteString(`</sessionId></SessionHeader></soapenv:Header><soapenv:Body>`)
+	buf.Write(body)
+	buf.WriteString(`</soapenv:Body></soapenv:Envelope>`)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(), &buf)
+	if err != nil {
+		return fmt.Errorf("Error creating %s request: %v", action, err)
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=UTF-8")
+	req.Header.Set("SOAPAction", `"`+action+`"`)
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error sending %s request: %v", action, err)
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("Error reading %s response: %v", action, err)
+	}
+
+	// faults are sent with a 500 status, so parse the envelope before looking at the status
+	var env envelope
+	if err := xml.Unmarshal(respBytes, &env); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%s failed with status %s", action, resp.Status)
+		}
+		return fmt.Errorf("Unable to unmarshal %s response: %v", action, err)
+	}
+	if env.Body.Fault != nil {
+		return env.Body.Fault
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s failed with status %s", action, resp.Status)
+	}
+	if err := xml.Unmarshal(env.Body.Content, response); err != nil {
+		return fmt.Errorf("Unable to unmarshal %s response: %v", action, err)
+	}
+	return nil
+}
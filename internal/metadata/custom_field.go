@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// CustomField is a field of an object, its full name is Object.Field, such as Widget__c.Size__c.
+// It is also the name field of a CustomObject, without a full name.
+// A formula field has the type of its result and the formula set. Elements are in the order of the WSDL.
+type CustomField struct {
+	FullName                 string       `xml:"fullName,omitempty"`
+	BusinessOwnerGroup       string       `xml:"businessOwnerGroup,omitempty"`
+	BusinessOwnerUser        string       `xml:"businessOwnerUser,omitempty"`
+	BusinessStatus           string       `xml:"businessStatus,omitempty"`
+	CaseSensitive            *bool        `xml:"caseSensitive,omitempty"`
+	ComplianceGroup          string       `xml:"complianceGroup,omitempty"`
+	DefaultValue             string       `xml:"defaultValue,omitempty"`
+	DeleteConstraint         string       `xml:"deleteConstraint,omitempty"`
+	Description              string       `xml:"description,omitempty"`
+	DisplayFormat            string       `xml:"displayFormat,omitempty"`
+	EncryptionScheme         string       `xml:"encryptionScheme,omitempty"`
+	ExternalId               *bool        `xml:"externalId,omitempty"`
+	Formula                  string       `xml:"formula,omitempty"`
+	FormulaTreatBlanksAs     string       `xml:"formulaTreatBlanksAs,omitempty"`
+	InlineHelpText           string       `xml:"inlineHelpText,omitempty"`
+	Label                    string       `xml:"label,omitempty"`
+	Length                   *int64       `xml:"length,omitempty"`
+	LookupFilter             *RawElement  `xml:"lookupFilter,omitempty"`
+	MaskChar                 string       `xml:"maskChar,omitempty"`
+	MaskType                 string       `xml:"maskType,omitempty"`
+	Precision                *int64       `xml:"precision,omitempty"`
+	ReferenceTo              string       `xml:"referenceTo,omitempty"`
+	RelationshipLabel        string       `xml:"relationshipLabel,omitempty"`
+	RelationshipName         string       `xml:"relationshipName,omitempty"`
+	ReparentableMasterDetail *bool        `xml:"reparentableMasterDetail,omitempty"`
+	Required                 *bool        `xml:"required,omitempty"`
+	Scale                    *int64       `xml:"scale,omitempty"`
+	SecurityClassification   string       `xml:"securityClassification,omitempty"`
+	StartingNumber           *int64       `xml:"startingNumber,omitempty"`
+	SummarizedField          string       `xml:"summarizedField,omitempty"`
+	SummaryFilterItems       []RawElement `xml:"summaryFilterItems,omitempty"`
+	SummaryForeignKey        string       `xml:"summaryForeignKey,omitempty"`
+	SummaryOperation         string       `xml:"summaryOperation,omitempty"`
+	TrackFeedHistory         *bool        `xml:"trackFeedHistory,omitempty"`
+	TrackHistory             *bool        `xml:"trackHistory,omitempty"`
+	TrackTrending            *bool        `xml:"trackTrending,omitempty"`
+	Type                     string       `xml:"type,omitempty"`
+	Unique                   *bool        `xml:"unique,omitempty"`
+	ValueSet                 *ValueSet    `xml:"valueSet,omitempty"`
+	VisibleLines             *int64       `xml:"visibleLines,omitempty"`
+	WriteRequiresMasterRead  *bool        `xml:"writeRequiresMasterRead,omitempty"`
+}
+
+func (CustomField) MetadataType() string { return "CustomField" }
+
+func (f CustomField) GetFullName() string { return f.FullName }
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import "encoding/xml"
+
+// CustomMetadata is a record of a custom metadata type, its full name is Type.RecordName where the
+// type is named without its __mdt suffix
+type CustomMetadata struct {
+	FullName  string                `xml:"fullName"`
+	Label     string                `xml:"label"`
+	Protected bool                  `xml:"protected"`
+	Values    []CustomMetadataValue `xml:"values"`
+}
+
+func (CustomMetadata) MetadataType() string { return "CustomMetadata" }
+
+func (m CustomMetadata) GetFullName() string { return m.FullName }
+
+// CustomMetadataValue is the value of a field of a custom metadata record
+type CustomMetadataValue struct {
+	Field string
+	// Type is the XML schema type of the value, such as xsd:string or xsd:boolean
+	Type string
+	// Value is nil when the field has no value
+	Value *string
+}
+
+type customMetadataValueXML struct {
+	Field string `xml:"field"`
+	Value *struct {
+		Attrs []xml.Attr `xml:",any,attr"`
+		Text  string     `xml:",chardata"`
+	} `xml:"value"`
+}
+
+func (v CustomMetadataValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	value := xml.StartElement{Name: xml.Name{Local: "value"}}
+	if v.Value == nil {
+		value.Attr = []xml.Attr{{Name: xml.Name{Local: "xsi:nil"}, Value: "true"}}
+	} else {
+		value.Attr = []xml.Attr{{Name: xml.Name{Local: "xsi:type"}, Value: v.Type}}
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.EncodeElement(v.Field, xml.StartElement{Name: xml.Name{Local: "field"}}); err != nil {
+		return err
+	}
+	text := ""
+	if v.Value != nil {
+		text = *v.Value
+	}
+	if err := e.EncodeElement(text, value); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+func (v *CustomMetadataValue) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var aux customMetadataValueXML
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+	*v = CustomMetadataValue{Field: aux.Field}
+	// fields without a value are returned with xsi:nil, or without the value element
+	if aux.Value == nil {
+		return nil
+	}
+	// the xsi prefix may not resolve to its namespace when the body is decoded apart from the
+	// envelope, so the attributes are matched by their local name
+	for _, attr := range aux.Value.Attrs {
+		switch attr.Name.Local {
+		case "nil":
+			if attr.Value == "true" {
+				return nil
+			}
+		case "type":
+			v.Type = attr.Value
+		}
+	}
+	v.Value = &aux.Value.Text
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// CustomObject holds the object level settings of a custom object, its fields are separate CustomField components.
+// Elements are in the order of the WSDL, flags are pointers so settings that aren't managed are sent back as read.
+// Child components with their own metadata types, such as fields, list views and record types, aren't modeled and
+// are left alone by updates.
+type CustomObject struct {
+	FullName                   string       `xml:"fullName"`
+	ActionOverrides            []RawElement `xml:"actionOverrides,omitempty"`
+	AllowInChatterGroups       *bool        `xml:"allowInChatterGroups,omitempty"`
+	CompactLayoutAssignment    string       `xml:"compactLayoutAssignment,omitempty"`
+	CustomHelp                 string       `xml:"customHelp,omitempty"`
+	CustomHelpPage             string       `xml:"customHelpPage,omitempty"`
+	DeploymentStatus           string       `xml:"deploymentStatus,omitempty"`
+	Description                string       `xml:"description,omitempty"`
+	EnableActivities           *bool        `xml:"enableActivities,omitempty"`
+	EnableBulkApi              *bool        `xml:"enableBulkApi,omitempty"`
+	EnableDataTranslation      *bool        `xml:"enableDataTranslation,omitempty"`
+	EnableDivisions            *bool        `xml:"enableDivisions,omitempty"`
+	EnableEnhancedLookup       *bool        `xml:"enableEnhancedLookup,omitempty"`
+	EnableFeeds                *bool        `xml:"enableFeeds,omitempty"`
+	EnableHistory              *bool        `xml:"enableHistory,omitempty"`
+	EnableLicensing            *bool        `xml:"enableLicensing,omitempty"`
+	EnableReports              *bool        `xml:"enableReports,omitempty"`
+	EnableSearch               *bool        `xml:"enableSearch,omitempty"`
+	EnableSharing              *bool        `xml:"enableSharing,omitempty"`
+	EnableStreamingApi         *bool        `xml:"enableStreamingApi,omitempty"`
+	ExternalSharingModel       string       `xml:"externalSharingModel,omitempty"`
+	HistoryRetentionPolicy     *RawElement  `xml:"historyRetentionPolicy,omitempty"`
+	Label                      string       `xml:"label,omitempty"`
+	NameField                  *CustomField `xml:"nameField,omitempty"`
+	PluralLabel                string       `xml:"pluralLabel,omitempty"`
+	ProfileSearchLayouts       []RawElement `xml:"profileSearchLayouts,omitempty"`
+	RecordTypeTrackFeedHistory *bool        `xml:"recordTypeTrackFeedHistory,omitempty"`
+	RecordTypeTrackHistory     *bool        `xml:"recordTypeTrackHistory,omitempty"`
+	SearchLayouts              *RawElement  `xml:"searchLayouts,omitempty"`
+	SharingModel               string       `xml:"sharingModel,omitempty"`
+	StartsWith                 string       `xml:"startsWith,omitempty"`
+	Visibility                 string       `xml:"visibility,omitempty"`
+}
+
+// RawElement keeps the content of a complex setting that isn't modeled as it was read, so an update
+// sends it back unchanged
+type RawElement struct {
+	InnerXML string `xml:",innerxml"`
+}
+
+func (CustomObject) MetadataType() string { return "CustomObject" }
+
+func (o CustomObject) GetFullName() string { return o.FullName }
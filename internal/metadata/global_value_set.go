@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// GlobalValueSet is a set of picklist values shared by picklist fields
+type GlobalValueSet struct {
+	FullName     string        `xml:"fullName"`
+	CustomValues []CustomValue `xml:"customValue"`
+	Description  string        `xml:"description,omitempty"`
+	MasterLabel  string        `xml:"masterLabel"`
+	Sorted       bool          `xml:"sorted"`
+}
+
+func (GlobalValueSet) MetadataType() string { return "GlobalValueSet" }
+
+func (s GlobalValueSet) GetFullName() string { return s.FullName }
+
+// StandardValueSet is the values of a standard picklist such as Industry, it can only be read and updated
+type StandardValueSet struct {
+	FullName       string          `xml:"fullName"`
+	Sorted         bool            `xml:"sorted"`
+	StandardValues []StandardValue `xml:"standardValue"`
+}
+
+func (StandardValueSet) MetadataType() string { return "StandardValueSet" }
+
+func (s StandardValueSet) GetFullName() string { return s.FullName }
+
+// StandardValue is a value of a standard picklist, some sets require the settings of their kind,
+// such as probability and forecastCategory for OpportunityStage
+type StandardValue struct {
+	CustomValue
+	AllowEmail       *bool  `xml:"allowEmail,omitempty"`
+	Closed           *bool  `xml:"closed,omitempty"`
+	Converted        *bool  `xml:"converted,omitempty"`
+	CssExposed       *bool  `xml:"cssExposed,omitempty"`
+	ForecastCategory string `xml:"forecastCategory,omitempty"`
+	GroupingString   string `xml:"groupingString,omitempty"`
+	HighPriority     *bool  `xml:"highPriority,omitempty"`
+	Probability      *int64 `xml:"probability,omitempty"`
+	ReverseRole      string `xml:"reverseRole,omitempty"`
+	Reviewed         *bool  `xml:"reviewed,omitempty"`
+	Won              *bool  `xml:"won,omitempty"`
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Metadata is a component such as a CustomObject, sent with its type in the xsi:type attribute
+type Metadata interface {
+	MetadataType() string
+	GetFullName() string
+}
+
+// typed encodes a component as the element of the request with the xsi:type of the component
+type typed struct {
+	Metadata
+}
+
+func (m typed) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: m.MetadataType()})
+	return e.EncodeElement(m.Metadata, start)
+}
+
+func typedComponents(components []Metadata) ([]typed, error) {
+	if len(components) > maxComponents {
+		return nil, fmt.Errorf("at most %d components can be sent in one call, got %d", maxComponents, len(components))
+	}
+	out := make([]typed, len(components))
+	for i, component := range components {
+		out[i] = typed{component}
+	}
+	return out, nil
+}
+
+// Error is the failure of a single component in a call
+type Error struct {
+	StatusCode string   `xml:"statusCode"`
+	Message    string   `xml:"message"`
+	Fields     []string `xml:"fields"`
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.StatusCode, e.Message)
+}
+
+// ResultError collects the errors of a component that wasn't saved or deleted
+type ResultError struct {
+	FullName string
+	Errors   []Error
+}
+
+func (e *ResultError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.FullName, strings.Join(messages, "; "))
+}
+
+func (e *ResultError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+func resultErr(success bool, fullName string, errors []Error) error {
+	if success {
+		return nil
+	}
+	if len(errors) == 0 {
+		errors = []Error{{StatusCode: "UNKNOWN_EXCEPTION", Message: "the call failed without an error message"}}
+	}
+	return &ResultError{FullName: fullName, Errors: errors}
+}
+
+type SaveResult struct {
+	FullName string  `xml:"fullName"`
+	Success  bool    `xml:"success"`
+	Errors   []Error `xml:"errors"`
+}
+
+// Err returns nil if the component was saved, or a *ResultError
+func (r SaveResult) Err() error {
+	return resultErr(r.Success, r.FullName, r.Errors)
+}
+
+type UpsertResult struct {
+	FullName string  `xml:"fullName"`
+	Created  bool    `xml:"created"`
+	Success  bool    `xml:"success"`
+	Errors   []Error `xml:"errors"`
+}
+
+func (r UpsertResult) Err() error {
+	return resultErr(r.Success, r.FullName, r.Errors)
+}
+
+type DeleteResult struct {
+	FullName string  `xml:"fullName"`
+	Success  bool    `xml:"success"`
+	Errors   []Error `xml:"errors"`
+}
+
+func (r DeleteResult) Err() error {
+	return resultErr(r.Success, r.FullName, r.Errors)
+}
+
+type createMetadataRequest struct {
+	XMLName  xml.Name `xml:"createMetadata"`
+	Metadata []typed  `xml:"metadata"`
+}
+
+type updateMetadataRequest struct {
+	XMLName  xml.Name `xml:"updateMetadata"`
+	Metadata []typed  `xml:"metadata"`
+}
+
+type upsertMetadataRequest struct {
+	XMLName  xml.Name `xml:"upsertMetadata"`
+	Metadata []typed  `xml:"metadata"`
+}
+
+type saveResponse struct {
+	Results []SaveResult `xml:"result"`
+}
+
+type upsertResponse struct {
+	Results []UpsertResult `xml:"result"`
+}
+
+type deleteMetadataRequest struct {
+	XMLName   xml.Name `xml:"deleteMetadata"`
+	Type      string   `xml:"type"`
+	FullNames []string `xml:"fullNames"`
+}
+
+type deleteResponse struct {
+	Results []DeleteResult `xml:"result"`
+}
+
+type readMetadataRequest struct {
+	XMLName   xml.Name `xml:"readMetadata"`
+	Type      string   `xml:"type"`
+	FullNames []string `xml:"fullNames"`
+}
+
+type readResponse[T Metadata] struct {
+	Records []T `xml:"result>records"`
+}
+
+// CreateMetadata creates the components, the results are in the same order as the components
+func (c *Client) CreateMetadata(ctx context.Context, components ...Metadata) ([]SaveResult, error) {
+	metadata, err := typedComponents(components)
+	if err != nil {
+		return nil, err
+	}
+	var resp saveResponse
+	if err := c.call(ctx, "createMetadata", createMetadataRequest{Metadata: metadata}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// UpdateMetadata replaces the components, fields left out are reset to their defaults
+func (c *Client) UpdateMetadata(ctx context.Context, components ...Metadata) ([]SaveResult, error) {
+	metadata, err := typedComponents(components)
+	if err != nil {
+		return nil, err
+	}
+	var resp saveResponse
+	if err := c.call(ctx, "updateMetadata", updateMetadataRequest{Metadata: metadata}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// UpsertMetadata creates the components that don't exist and replaces those that do
+func (c *Client) UpsertMetadata(ctx context.Context, components ...Metadata) ([]UpsertResult, error) {
+	metadata, err := typedComponents(components)
+	if err != nil {
+		return nil, err
+	}
+	var resp upsertResponse
+	if err := c.call(ctx, "upsertMetadata", upsertMetadataRequest{Metadata: metadata}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+func (c *Client) DeleteMetadata(ctx context.Context, metadataType string, fullNames ...string) ([]DeleteResult, error) {
+	if len(fullNames) > maxComponents {
+		return nil, fmt.Errorf("at most %d components can be sent in one call, got %d", maxComponents, len(fullNames))
+	}
+	var resp deleteResponse
+	if err := c.call(ctx, "deleteMetadata", deleteMetadataRequest{Type: metadataType, FullNames: fullNames}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// ReadMetadata reads components of type T by full name.
+// Components that don't exist are left out of the result rather than returned as an error.
+func ReadMetadata[T Metadata](ctx context.Context, c *Client, fullNames ...string) ([]T, error) {
+	if len(fullNames) > maxComponents {
+		return nil, fmt.Errorf("at most %d components can be sent in one call, got %d", maxComponents, len(fullNames))
+	}
+	var zero T
+	var resp readResponse[T]
+	if err := c.call(ctx, "readMetadata", readMetadataRequest{Type: zero.MetadataType(), FullNames: fullNames}, &resp); err != nil {
+		return nil, err
+	}
+	// a missing component is returned as an empty record
+	records := make([]T, 0, len(resp.Records))
+	for _, record := range resp.Records {
+		if record.GetFullName() != "" {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// Create creates a single component, returning the error of its result
+func (c *Client) Create(ctx context.Context, component Metadata) error {
+	results, err := c.CreateMetadata(ctx, component)
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return fmt.Errorf("expected 1 result creating %s, got %d", component.GetFullName(), len(results))
+	}
+	return results[0].Err()
+}
+
+// Update replaces a single component, returning the error of its result
+func (c *Client) Update(ctx context.Context, component Metadata) error {
+	results, err := c.UpdateMetadata(ctx, component)
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return fmt.Errorf("expected 1 result updating %s, got %d", component.GetFullName(), len(results))
+	}
+	return results[0].Err()
+}
+
+// Delete deletes a single component, returning the error of its result
+func (c *Client) Delete(ctx context.Context, metadataType string, fullName string) error {
+	results, err := c.DeleteMetadata(ctx, metadataType, fullName)
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return fmt.Errorf("expected 1 result deleting %s, got %d", fullName, len(results))
+	}
+	return results[0].Err()
+}
+
+// Read reads a single component of type T, found is false if it doesn't exist
+func Read[T Metadata](ctx context.Context, c *Client, fullName string) (component T, found bool, err error) {
+	records, err := ReadMetadata[T](ctx, c, fullName)
+	if err != nil || len(records) == 0 {
+		return component, false, err
+	}
+	return records[0], true, nil
+}
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+type testObject struct {
+	FullName    string `xml:"fullName"`
+	Label       string `xml:"label,omitempty"`
+	PluralLabel string `xml:"pluralLabel,omitempty"`
+}
+
+func (testObject) MetadataType() string { return "CustomObject" }
+
+func (o testObject) GetFullName() string { return o.FullName }
+
+// fixtureServer serves the fixture and records the request it got
+func fixtureServer(t *testing.T, fixture string, status int, got *string) *Client {
+	t.Helper()
+	body, err := os.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/Soap/m/53.0" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("SOAPAction") == "" {
+			t.Error("missing SOAPAction header")
+		}
+		req, _ := io.ReadAll(r.Body)
+		if got != nil {
+			*got = string(req)
+		}
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return New(server.URL, "00D<token>&", "v53.0")
+}
+
+func TestCreateMetadata(t *testing.T) {
+	var req string
+	c := fixtureServer(t, "create_metadata.xml", http.StatusOK, &req)
+	results, err := c.CreateMetadata(context.Background(),
+		testObject{FullName: "Widget__c", Label: "Widget", PluralLabel: "Widgets"},
+		testObject{FullName: "Gadget__c", Label: "Gadget"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<sessionId>00D&lt;token&gt;&amp;</sessionId>`,
+		`<createMetadata><metadata xsi:type="CustomObject"><fullName>Widget__c</fullName><label>Widget</label><pluralLabel>Widgets</pluralLabel></metadata>`,
+	} {
+		if !strings.Contains(req, want) {
+			t.Errorf("request doesn't contain %s:\n%s", want, req)
+		}
+	}
+	// the request has to be well formed for the namespaces to apply
+	if err := xml.Unmarshal([]byte(req), new(interface{})); err != nil {
+		t.Errorf("invalid request xml: %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if err := results[0].Err(); err != nil {
+		t.Errorf("expected Widget__c to succeed, got %v", err)
+	}
+	err = results[1].Err()
+	var resultErr *ResultError
+	if !errors.As(err, &resultErr) {
+		t.Fatalf("expected a ResultError, got %v", err)
+	}
+	if got, want := err.Error(), "Gadget__c: REQUIRED_FIELD_MISSING: Required field is missing: pluralLabel"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	var fieldErr Error
+	if !errors.As(err, &fieldErr) || len(fieldErr.Fields) != 1 || fieldErr.Fields[0] != "pluralLabel" {
+		t.Errorf("expected the error to name the pluralLabel field, got %#v", fieldErr)
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	var req string
+	c := fixtureServer(t, "update_metadata.xml", http.StatusOK, &req)
+	results, err := c.UpdateMetadata(context.Background(), testObject{FullName: "Widget__c", Label: "Widget"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(req, `<updateMetadata><metadata xsi:type="CustomObject">`) {
+		t.Errorf("unexpected request:\n%s", req)
+	}
+	if len(results) != 1 || results[0].Err() != nil {
+		t.Errorf("unexpected results %#v", results)
+	}
+}
+
+func TestUpsertMetadata(t *testing.T) {
+	c := fixtureServer(t, "upsert_metadata.xml", http.StatusOK, nil)
+	results, err := c.UpsertMetadata(context.Background(), testObject{FullName: "Widget__c"}, testObject{FullName: "Gadget__c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || !results[0].Created || results[1].Created {
+		t.Errorf("unexpected results %#v", results)
+	}
+}
+
+func TestDeleteMetadata(t *testing.T) {
+	var req string
+	c := fixtureServer(t, "delete_metadata.xml", http.StatusOK, &req)
+	results, err := c.DeleteMetadata(context.Background(), "CustomObject", "Missing__c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(req, `<deleteMetadata><type>CustomObject</type><fullNames>Missing__c</fullNames></deleteMetadata>`) {
+		t.Errorf("unexpected request:\n%s", req)
+	}
+	var fieldErr Error
+	if len(results) != 1 || !errors.As(results[0].Err(), &fieldErr) || fieldErr.StatusCode != "INVALID_CROSS_REFERENCE_KEY" {
+		t.Errorf("unexpected results %#v", results)
+	}
+}
+
+func TestReadMetadata(t *testing.T) {
+	var req string
+	c := fixtureServer(t, "read_metadata.xml", http.StatusOK, &req)
+	records, err := ReadMetadata[testObject](context.Background(), c, "Widget__c", "Missing__c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(req, `<readMetadata><type>CustomObject</type><fullNames>Widget__c</fullNames><fullNames>Missing__c</fullNames></readMetadata>`) {
+		t.Errorf("unexpected request:\n%s", req)
+	}
+	want := []testObject{{FullName: "Widget__c", Label: "Widget", PluralLabel: "Widgets"}}
+	if len(records) != 1 || records[0] != want[0] {
+		t.Errorf("got %#v, want %#v", records, want)
+	}
+}
+
+func TestFault(t *testing.T) {
+	c := fixtureServer(t, "fault_invalid_session.xml", http.StatusInternalServerError, nil)
+	_, err := c.CreateMetadata(context.Background(), testObject{FullName: "Widget__c"})
+	var fault *Fault
+	if !errors.As(err, &fault) {
+		t.Fatalf("expected a Fault, got %v", err)
+	}
+	if fault.ExceptionCode != "INVALID_SESSION_ID" {
+		t.Errorf("got exception code %q", fault.ExceptionCode)
+	}
+	if got, want := err.Error(), "INVALID_SESSION_ID: Invalid Session ID found in SessionHeader: Illegal Session"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestHTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	_, err := New(server.URL, "token", "53.0").DeleteMetadata(context.Background(), "CustomObject", "Widget__c")
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected the status in the error, got %v", err)
+	}
+}
+
+func TestTooManyComponents(t *testing.T) {
+	c := New("https://example.my.salesforce.com", "token", "53.0")
+	components := make([]Metadata, maxComponents+1)
+	for i := range components {
+		components[i] = testObject{FullName: "Widget__c"}
+	}
+	if _, err := c.CreateMetadata(context.Background(), components...); err == nil {
+		t.Error("expected an error for more than 10 components")
+	}
+}
+
+func TestReadCustomObject(t *testing.T) {
+	c := fixtureServer(t, "read_custom_object.xml", http.StatusOK, nil)
+	object, found, err := Read[CustomObject](context.Background(), c, "Widget__c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found {
+		t.Fatal("expected Widget__c to be found")
+	}
+	if object.Label != "Widget" || object.SharingModel != "ReadWrite" || object.NameField == nil || object.NameField.Type != "AutoNumber" {
+		t.Errorf("unexpected object %#v", object)
+	}
+	// the next auto number isn't returned by reads
+	if object.NameField.StartingNumber != nil {
+		t.Errorf("unexpected starting number %v", *object.NameField.StartingNumber)
+	}
+	if object.EnableHistory == nil || !*object.EnableHistory || object.EnableSearch == nil || *object.EnableSearch {
+		t.Errorf("unexpected flags %#v", object)
+	}
+
+	// settings that aren't managed are sent back as read by updates
+	var req string
+	c = fixtureServer(t, "update_metadata.xml", http.StatusOK, &req)
+	if err := c.Update(context.Background(), object); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"<actionOverrides><actionName>Accept</actionName>",
+		"<allowInChatterGroups>false</allowInChatterGroups>",
+		"<enableLicensing>false</enableLicensing>",
+		"<externalSharingModel>Private</externalSharingModel>",
+		"<visibility>Public</visibility>",
+	} {
+		if !strings.Contains(strings.Join(strings.Fields(req), ""), strings.Join(strings.Fields(want), "")) {
+			t.Errorf("update doesn't contain %s:\n%s", want, req)
+		}
+	}
+	if strings.Contains(req, "<fields>") {
+		t.Errorf("expected the fields to be left out of the update:\n%s", req)
+	}
+}
+
+func TestCreateError(t *testing.T) {
+	c := fixtureServer(t, "create_metadata.xml", http.StatusOK, nil)
+	// the fixture has two results, a single create expects one
+	if err := c.Create(context.Background(), CustomObject{FullName: "Widget__c"}); err == nil {
+		t.Error("expected an error for an unexpected number of results")
+	}
+}
+
+func TestReadCustomField(t *testing.T) {
+	c := fixtureServer(t, "read_custom_field.xml", http.StatusOK, nil)
+	field, found, err := Read[CustomField](context.Background(), c, "Widget__c.Color__c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || field.Type != "Picklist" || field.ValueSet == nil || field.ValueSet.ValueSetDefinition == nil {
+		t.Fatalf("unexpected field %#v", field)
+	}
+	values := field.ValueSet.ValueSetDefinition.Values
+	if len(values) != 3 || !values[0].Default || values[1].Label != "Dark Blue" {
+		t.Errorf("unexpected values %#v", values)
+	}
+	if !values[1].Active() || values[2].Active() {
+		t.Errorf("expected only Green to be inactive")
+	}
+
+	// settings that aren't managed are sent back as read by updates
+	var req string
+	c = fixtureServer(t, "update_metadata.xml", http.StatusOK, &req)
+	if err := c.Update(context.Background(), field); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"<businessStatus>Active</businessStatus>",
+		"<securityClassification>Internal</securityClassification>",
+		"<trackHistory>true</trackHistory>",
+	} {
+		if !strings.Contains(req, want) {
+			t.Errorf("update doesn't contain %s:\n%s", want, req)
+		}
+	}
+}
+
+func TestCreateValidationRuleError(t *testing.T) {
+	var req string
+	c := fixtureServer(t, "create_validation_rule_error.xml", http.StatusOK, &req)
+	err := c.Create(context.Background(), ValidationRule{
+		FullName:              "Widget__c.Amount_Positive",
+		Active:                true,
+		ErrorConditionFormula: "Amount < 0",
+		ErrorMessage:          "Amount can't be negative",
+	})
+	if !strings.Contains(req, `<metadata xsi:type="ValidationRule"><fullName>Widget__c.Amount_Positive</fullName><active>true</active><errorConditionFormula>Amount &lt; 0</errorConditionFormula>`) {
+		t.Errorf("unexpected request:\n%s", req)
+	}
+	var fieldErr Error
+	if !errors.As(err, &fieldErr) || len(fieldErr.Fields) != 1 || fieldErr.Fields[0] != "errorConditionFormula" {
+		t.Errorf("expected an error on errorConditionFormula, got %v", err)
+	}
+}
+
+func TestStandardValueSet(t *testing.T) {
+	c := fixtureServer(t, "read_standard_value_set.xml", http.StatusOK, nil)
+	set, found, err := Read[StandardValueSet](context.Background(), c, "OpportunityStage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || len(set.StandardValues) != 2 {
+		t.Fatalf("unexpected set %#v", set)
+	}
+	won := set.StandardValues[1]
+	if won.FullName != "Closed Won" || won.Won == nil || !*won.Won || won.Probability == nil || *won.Probability != 100 {
+		t.Errorf("unexpected value %#v", won)
+	}
+
+	// the embedded custom value is encoded first, in the order of the WSDL
+	out, err := xml.Marshal(won)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<StandardValue><fullName>Closed Won</fullName><default>false</default><label>Closed Won</label><closed>true</closed><forecastCategory>Closed</forecastCategory><probability>100</probability><won>true</won></StandardValue>`
+	if string(out) != want {
+		t.Errorf("got %s, want %s", out, want)
+	}
+}
+
+func TestReadRecordType(t *testing.T) {
+	c := fixtureServer(t, "read_record_type.xml", http.StatusOK, nil)
+	recordType, found, err := Read[RecordType](context.Background(), c, "Lead.Partner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || recordType.BusinessProcess != "Partner Leads" || len(recordType.PicklistValues) != 2 {
+		t.Fatalf("unexpected record type %#v", recordType)
+	}
+	source := recordType.PicklistValues[0]
+	if source.Picklist != "LeadSource" || len(source.Values) != 2 || !source.Values[0].Default {
+		t.Errorf("unexpected picklist values %#v", source)
+	}
+	if value := DecodePicklistValue(recordType.PicklistValues[1].Values[0].FullName); value != "Mr." {
+		t.Errorf("expected Mr., got %q", value)
+	}
+}
+
+func TestEncodePicklistValue(t *testing.T) {
+	for value, want := range map[string]string{
+		"Partner Referral": "Partner Referral",
+		"Mr.":              "Mr%2E",
+		"R&D (West)":       "R%26D %28West%29",
+		"100%":             "100%25",
+		"Größe":            "Größe",
+	} {
+		if got := EncodePicklistValue(value); got != want {
+			t.Errorf("EncodePicklistValue(%q) = %q, want %q", value, got, want)
+		}
+		if got := DecodePicklistValue(want); got != value {
+			t.Errorf("DecodePicklistValue(%q) = %q, want %q", want, got, value)
+		}
+	}
+}
+
+func TestCustomMetadata(t *testing.T) {
+	c := fixtureServer(t, "read_custom_metadata.xml", http.StatusOK, nil)
+	record, found, err := Read[CustomMetadata](context.Background(), c, "Feature_Toggle.Checkout_V2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || record.Label != "Checkout V2" || len(record.Values) != 4 {
+		t.Fatalf("unexpected record %#v", record)
+	}
+	enabled := record.Values[0]
+	if enabled.Field != "Enabled__c" || enabled.Type != "xsd:boolean" || enabled.Value == nil || *enabled.Value != "true" {
+		t.Errorf("unexpected value %#v", enabled)
+	}
+	for _, v := range record.Values[2:] {
+		if v.Value != nil {
+			t.Errorf("expected no value for %s, got %q", v.Field, *v.Value)
+		}
+	}
+
+	out, err := xml.Marshal(CustomMetadata{
+		FullName: "Feature_Toggle.Checkout_V2",
+		Label:    "Checkout V2",
+		Values: []CustomMetadataValue{
+			record.Values[1],
+			{Field: "Owner_Team__c"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<CustomMetadata><fullName>Feature_Toggle.Checkout_V2</fullName><label>Checkout V2</label><protected>false</protected>` +
+		`<values><field>Rollout_Percent__c</field><value xsi:type="xsd:double">25.0</value></values>` +
+		`<values><field>Owner_Team__c</field><value xsi:nil="true"></value></values></CustomMetadata>`
+	if string(out) != want {
+		t.Errorf("got %s, want %s", out, want)
+	}
+}
+
+func TestReadCspTrustedSite(t *testing.T) {
+	c := fixtureServer(t, "read_csp_trusted_site.xml", http.StatusOK, nil)
+	site, found, err := Read[CspTrustedSite](context.Background(), c, "Maps_Tiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := CspTrustedSite{
+		FullName:                 "Maps_Tiles",
+		Context:                  "LEX",
+		EndpointUrl:              "https://*.tiles.example.com",
+		IsActive:                 true,
+		IsApplicableToConnectSrc: true,
+		IsApplicableToImgSrc:     true,
+	}
+	if !found || site != want {
+		t.Errorf("expected %+v, got %+v", want, site)
+	}
+}
+
+func TestReadExternalCredential(t *testing.T) {
+	c := fixtureServer(t, "read_external_credential.xml", http.StatusOK, nil)
+	credential, found, err := Read[ExternalCredential](context.Background(), c, "Payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || credential.AuthenticationProtocol != "Custom" || len(credential.ExternalCredentialParameters) != 2 {
+		t.Fatalf("unexpected external credential %#v", credential)
+	}
+	principal := credential.ExternalCredentialParameters[1]
+	if principal.ParameterName != "Integration" || principal.ParameterType != "NamedPrincipal" || principal.SequenceNumber == nil || *principal.SequenceNumber != 1 {
+		t.Errorf("unexpected principal %#v", principal)
+	}
+}
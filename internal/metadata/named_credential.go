@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// ExternalCredential holds the authentication of callouts made through named credentials, its
+// principals are parameters of type NamedPrincipal or PerUserPrincipal
+type ExternalCredential struct {
+	FullName                     string                        `xml:"fullName"`
+	AuthenticationProtocol       string                        `xml:"authenticationProtocol"`
+	Description                  string                        `xml:"description,omitempty"`
+	ExternalCredentialParameters []ExternalCredentialParameter `xml:"externalCredentialParameters"`
+	Label                        string                        `xml:"label"`
+}
+
+func (ExternalCredential) MetadataType() string { return "ExternalCredential" }
+
+func (c ExternalCredential) GetFullName() string { return c.FullName }
+
+// ExternalCredentialParameter is a parameter of an external credential, the value of AuthProvider
+// parameters is in AuthProvider and the value of SigningCertificate parameters in Certificate
+type ExternalCredentialParameter struct {
+	AuthProvider   string `xml:"authProvider,omitempty"`
+	Certificate    string `xml:"certificate,omitempty"`
+	Description    string `xml:"description,omitempty"`
+	ParameterGroup string `xml:"parameterGroup,omitempty"`
+	ParameterName  string `xml:"parameterName"`
+	ParameterType  string `xml:"parameterType"`
+	ParameterValue string `xml:"parameterValue,omitempty"`
+	SequenceNumber *int64 `xml:"sequenceNumber,omitempty"`
+}
+
+// NamedCredential is the endpoint of callouts authenticated by an external credential
+type NamedCredential struct {
+	FullName                    string                     `xml:"fullName"`
+	AllowMergeFieldsInBody      bool                       `xml:"allowMergeFieldsInBody"`
+	AllowMergeFieldsInHeader    bool                       `xml:"allowMergeFieldsInHeader"`
+	CalloutStatus               string                     `xml:"calloutStatus,omitempty"`
+	Description                 string                     `xml:"description,omitempty"`
+	GenerateAuthorizationHeader bool                       `xml:"generateAuthorizationHeader"`
+	Label                       string                     `xml:"label"`
+	NamedCredentialParameters   []NamedCredentialParameter `xml:"namedCredentialParameters"`
+	NamedCredentialType         string                     `xml:"namedCredentialType"`
+}
+
+func (NamedCredential) MetadataType() string { return "NamedCredential" }
+
+func (c NamedCredential) GetFullName() string { return c.FullName }
+
+// NamedCredentialParameter is a parameter of a named credential such as its Url or ExternalCredential
+type NamedCredentialParameter struct {
+	ExternalCredential string `xml:"externalCredential,omitempty"`
+	ParameterName      string `xml:"parameterName,omitempty"`
+	ParameterType      string `xml:"parameterType"`
+	ParameterValue     string `xml:"parameterValue,omitempty"`
+	SequenceNumber     *int64 `xml:"sequenceNumber,omitempty"`
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RecordType offers a business process and a subset of picklist values to records, its full name is Object.DeveloperName
+type RecordType struct {
+	FullName        string                    `xml:"fullName"`
+	Active          bool                      `xml:"active"`
+	BusinessProcess string                    `xml:"businessProcess,omitempty"`
+	Description     string                    `xml:"description,omitempty"`
+	Label           string                    `xml:"label"`
+	PicklistValues  []RecordTypePicklistValue `xml:"picklistValues"`
+}
+
+func (RecordType) MetadataType() string { return "RecordType" }
+
+func (r RecordType) GetFullName() string { return r.FullName }
+
+// RecordTypePicklistValue is the values of a picklist field available to a record type
+type RecordTypePicklistValue struct {
+	Picklist string          `xml:"picklist"`
+	Values   []PicklistValue `xml:"values"`
+}
+
+// PicklistValue is a value of a picklist, its full name is URL encoded in record types, see
+// EncodePicklistValue
+type PicklistValue struct {
+	FullName string `xml:"fullName"`
+	Default  bool   `xml:"default"`
+}
+
+// EncodePicklistValue encodes a value for the full name of a PicklistValue, Salesforce percent
+// encodes the characters other than letters, digits, spaces, underscores and hyphens, so that
+// Mr. becomes Mr%2E
+func EncodePicklistValue(value string) string {
+	var b strings.Builder
+	for _, c := range []byte(value) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == ' ', c == '_', c == '-', c >= 0x80:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// DecodePicklistValue decodes the full name of a PicklistValue, returning it unchanged when it
+// isn't valid percent encoding
+func DecodePicklistValue(fullName string) string {
+	value, err := url.PathUnescape(fullName)
+	if err != nil {
+		return fullName
+	}
+	return value
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// RemoteSiteSetting allows Apex callouts and other requests from the org to a site
+type RemoteSiteSetting struct {
+	FullName                string `xml:"fullName"`
+	Description             string `xml:"description,omitempty"`
+	DisableProtocolSecurity bool   `xml:"disableProtocolSecurity"`
+	IsActive                bool   `xml:"isActive"`
+	Url                     string `xml:"url"`
+}
+
+func (RemoteSiteSetting) MetadataType() string { return "RemoteSiteSetting" }
+
+func (s RemoteSiteSetting) GetFullName() string { return s.FullName }
+
+// CspTrustedSite allows Lightning pages to load resources from a site, for the content security policy
+// directives it applies to
+type CspTrustedSite struct {
+	FullName                 string `xml:"fullName"`
+	CanAccessCamera          bool   `xml:"canAccessCamera"`
+	CanAccessMicrophone      bool   `xml:"canAccessMicrophone"`
+	Context                  string `xml:"context,omitempty"`
+	Description              string `xml:"description,omitempty"`
+	EndpointUrl              string `xml:"endpointUrl"`
+	IsActive                 bool   `xml:"isActive"`
+	IsApplicableToConnectSrc bool   `xml:"isApplicableToConnectSrc"`
+	IsApplicableToFontSrc    bool   `xml:"isApplicableToFontSrc"`
+	IsApplicableToFrameSrc   bool   `xml:"isApplicableToFrameSrc"`
+	IsApplicableToImgSrc     bool   `xml:"isApplicableToImgSrc"`
+	IsApplicableToMediaSrc   bool   `xml:"isApplicableToMediaSrc"`
+	IsApplicableToStyleSrc   bool   `xml:"isApplicableToStyleSrc"`
+}
+
+func (CspTrustedSite) MetadataType() string { return "CspTrustedSite" }
+
+func (s CspTrustedSite) GetFullName() string { return s.FullName }
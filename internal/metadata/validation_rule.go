@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// ValidationRule blocks saving records when the formula is true, its full name is Object.Rule
+type ValidationRule struct {
+	FullName              string `xml:"fullName"`
+	Active                bool   `xml:"active"`
+	Description           string `xml:"description,omitempty"`
+	ErrorConditionFormula string `xml:"errorConditionFormula"`
+	ErrorDisplayField     string `xml:"errorDisplayField,omitempty"`
+	ErrorMessage          string `xml:"errorMessage"`
+}
+
+func (ValidationRule) MetadataType() string { return "ValidationRule" }
+
+func (r ValidationRule) GetFullName() string { return r.FullName }
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadata
+
+// ValueSet is the values of a picklist field, either defined on the field or the name of a global value set
+type ValueSet struct {
+	Restricted         *bool               `xml:"restricted,omitempty"`
+	ValueSetDefinition *ValueSetDefinition `xml:"valueSetDefinition,omitempty"`
+	ValueSetName       string              `xml:"valueSetName,omitempty"`
+}
+
+type ValueSetDefinition struct {
+	Sorted bool          `xml:"sorted"`
+	Values []CustomValue `xml:"value"`
+}
+
+// CustomValue is a picklist value, the full name is its API name
+type CustomValue struct {
+	FullName    string `xml:"fullName"`
+	Default     bool   `xml:"default"`
+	Description string `xml:"description,omitempty"`
+	IsActive    *bool  `xml:"isActive,omitempty"`
+	Label       string `xml:"label,omitempty"`
+}
+
+// Active is true unless the value was deactivated, which keeps it on existing records
+func (v CustomValue) Active() bool {
+	return v.IsActive == nil || *v.IsActive
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// gen writes picklists.go from a saved sobjects/User/describe response
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// picklistVars maps the User fields to the variables generated for them, in output order
+var picklistVars = []struct {
+	Field string
+	Var   string
+}{
+	{"TimeZoneSidKey", "TimeZoneSidKeys"},
+	{"LocaleSidKey", "LocaleSidKeys"},
+	{"EmailEncodingKey", "EmailEncodingKeys"},
+	{"LanguageLocaleKey", "LanguageLocaleKeys"},
+}
+
+type describe struct {
+	Name   string `json:"name"`
+	Fields []struct {
+		Name           string `json:"name"`
+		PicklistValues []struct {
+			Active bool   `json:"active"`
+			Label  string `json:"label"`
+			Value  string `json:"value"`
+		} `json:"picklistValues"`
+	} `json:"fields"`
+}
+
+func main() {
+	in := flag.String("describe", "testdata/user_describe.json", "saved sobjects/User/describe response")
+	out := flag.String("out", "picklists.go", "file to write")
+	flag.Parse()
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(data)
+	if err != nil {
+		log.Fatalf("%s: %s", *in, err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate renders the picklist variables, keeping the describe order so a new describe diffs cleanly
+func generate(data []byte) ([]byte, error) {
+	var desc describe
+	if err := json.Unmarshal(data, &desc); err != nil {
+		return nil, err
+	}
+	if desc.Name != "User" {
+		return nil, fmt.Errorf("expected a describe of User, got %q", desc.Name)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by go run ./gen; DO NOT EDIT.
+
+package picklists
+
+// The User picklists are validated against the org's describe when the provider is configured,
+// these lists are the fallback when it can't be described, such as during terraform validate.
+`)
+	for _, pv := range picklistVars {
+		found := false
+		for _, f := range desc.Fields {
+			if f.Name != pv.Field {
+				continue
+			}
+			found = true
+			fmt.Fprintf(&buf, "\nvar %s = []string{\n", pv.Var)
+			for _, v := range f.PicklistValues {
+				if !v.Active {
+					continue
+				}
+				fmt.Fprintf(&buf, "%s,", strconv.Quote(v.Value))
+				if label := strings.TrimSpace(v.Label); label != "" && label != v.Value {
+					fmt.Fprintf(&buf, " // %s", label)
+				}
+				buf.WriteString("\n")
+			}
+			buf.WriteString("}\n")
+		}
+		if !found {
+			return nil, fmt.Errorf("field %s not found", pv.Field)
+		}
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGenerateUpToDate(t *testing.T) {
+	data, err := os.ReadFile("../testdata/user_describe.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("../picklists.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("picklists.go is out of date with testdata/user_describe.json, run go generate ./internal/picklists/...")
+	}
+}
+
+func TestGenerateSkipsInactive(t *testing.T) {
+	data := []byte(`{"name": "User", "fields": [
+		{"name": "TimeZoneSidKey", "picklistValues": [
+			{"active": true, "label": "(GMT+00:00) Greenwich Mean Time (GMT)", "value": "GMT"},
+			{"active": false, "label": "Old", "value": "Old/Zone"}
+		]},
+		{"name": "LocaleSidKey", "picklistValues": [{"active": true, "label": "en_US", "value": "en_US"}]},
+		{"name": "EmailEncodingKey", "picklistValues": []},
+		{"name": "LanguageLocaleKey", "picklistValues": []}
+	]}`)
+	got, err := generate(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(got, []byte(`"GMT", // (GMT+00:00) Greenwich Mean Time (GMT)`)) {
+		t.Errorf("missing labelled value:\n%s", got)
+	}
+	if bytes.Contains(got, []byte("Old/Zone")) {
+		t.Errorf("inactive value generated:\n%s", got)
+	}
+	if bytes.Contains(got, []byte(`"en_US", //`)) {
+		t.Errorf("label equal to the value should be omitted:\n%s", got)
+	}
+}
+
+func TestGenerateMissingField(t *testing.T) {
+	if _, err := generate([]byte(`{"name": "User", "fields": []}`)); err == nil {
+		t.Error("expected an error for a describe without the picklist fields")
+	}
+	if _, err := generate([]byte(`{"name": "Account", "fields": []}`)); err == nil {
+		t.Error("expected an error for a describe of another object")
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package picklists holds the static picklist values used when the org can't be described.
+package picklists
+
+// picklists.go is generated from a saved sobjects/User/describe response, to update it replace
+// testdata/user_describe.json with a fresh describe and run go generate ./internal/picklists/...
+//go:generate go run ./gen -describe testdata/user_describe.json -out picklists.go
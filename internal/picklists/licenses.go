@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package picklists
+
+// LicenseDefinitionKey isn't a picklist on UserLicense so it can't be generated from a describe,
+// this list is maintained by hand.
+var LicenseDefinitionKeys = []string{
+	"SFDC",
+	"AUL",
+	"PID_Customer_Community",
+	"PID_Customer_Community_Plus",
+	"PID_Identity_User",
+	"PID_XOrg_Proxy_User",
+	"PID_STRATEGIC_PRM",
+	"FDC_ISV_SUB",
+	"PLATFORM_PORTAL",
+	"PID_Partner_Community",
+	"HIGH_VOLUME_PORTAL",
+	"PID_Customer_Portal_Standard",
+	"PID_Partner_Community_Login",
+	"PID_WCUSER",
+	"PID_STANDARD_PRM",
+	"PID_FDC_FREE",
+	"PID_External_Identity",
+	"PID_Customer_Community_Login",
+	"PID_Customer_Portal_Basic",
+	"FDC_SUB",
+	"PID_Customer_Community_Plus_Login",
+	"CSN_External_User",
+	"CSN_User",
+	"PID_CpqIntegration",
+	"High_Volume_Customer_Portal_User",
+	"Platform_Portal_User",
+	"INSIGHTS_INTEGRATION_USER",
+	"CLOUD_INTEGRATION_USER",
+	"PID_External_Apps_Login",
+}
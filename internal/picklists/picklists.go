@@ -1,372 +1,343 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+// Code generated by go run ./gen; DO NOT EDIT.
+
 package picklists
 
-// TODO use describe API to grab official up to date lists and embed
+// The User picklists are validated against the org's describe when the provider is configured,
+// these lists are the fallback when it can't be described, such as during terraform validate.
 
 var TimeZoneSidKeys = []string{
-	"Pacific/Kiritimati",
-	"Pacific/Chatham",
-	"Pacific/Auckland",
-	"Pacific/Enderbury",
-	"Pacific/Fiji",
-	"Pacific/Tongatapu",
-	"Asia/Kamchatka",
-	"Pacific/Norfolk",
-	"Australia/Lord_Howe",
-	"Australia/Sydney",
-	"Pacific/Guadalcanal",
-	"Australia/Adelaide",
-	"Australia/Darwin",
-	"Asia/Seoul",
-	"Asia/Tokyo",
-	"Asia/Hong_Kong",
-	"Asia/Kuala_Lumpur",
-	"Asia/Manila",
-	"Asia/Shanghai",
-	"Asia/Singapore",
-	"Asia/Taipei",
-	"Australia/Perth",
-	"Asia/Bangkok",
-	"Asia/Ho_Chi_Minh",
-	"Asia/Jakarta",
-	"Asia/Rangoon",
-	"Asia/Dhaka",
-	"Asia/Yekaterinburg",
-	"Asia/Kathmandu",
-	"Asia/Colombo",
-	"Asia/Kolkata",
-	"Asia/Karachi",
-	"Asia/Tashkent",
-	"Asia/Kabul",
-	"Asia/Dubai",
-	"Asia/Tbilisi",
-	"Europe/Moscow",
-	"Asia/Tehran",
-	"Africa/Nairobi",
-	"Asia/Baghdad",
-	"Asia/Kuwait",
-	"Asia/Riyadh",
-	"Europe/Minsk",
-	"Africa/Cairo",
-	"Africa/Johannesburg",
-	"Asia/Jerusalem",
-	"Europe/Athens",
-	"Europe/Bucharest",
-	"Europe/Helsinki",
-	"Europe/Istanbul",
-	"Africa/Algiers",
-	"Europe/Amsterdam",
-	"Europe/Berlin",
-	"Europe/Brussels",
-	"Europe/Paris",
-	"Europe/Prague",
-	"Europe/Rome",
-	"Europe/Dublin",
-	"Europe/Lisbon",
-	"Europe/London",
-	"Atlantic/Cape_Verde",
-	"America/Sao_Paulo",
-	"Atlantic/South_Georgia",
-	"America/Argentina/Buenos_Aires",
-	"America/Santiago",
-	"America/St_Johns",
-	"America/Halifax",
-	"America/Puerto_Rico",
-	"Atlantic/Bermuda",
-	"America/Caracas",
-	"America/Bogota",
-	"America/Indiana/Indianapolis",
-	"America/Lima",
-	"America/New_York",
-	"America/Panama",
-	"America/Chicago",
-	"America/El_Salvador",
-	"America/Mexico_City",
-	"America/Denver",
-	"America/Phoenix",
-	"America/Los_Angeles",
-	"America/Tijuana",
-	"America/Anchorage",
-	"Pacific/Honolulu",
-	"Pacific/Niue",
-	"Pacific/Pago_Pago",
+	"Pacific/Kiritimati",             // (GMT+14:00) Pacific/Kiritimati
+	"Pacific/Chatham",                // (GMT+13:45) Pacific/Chatham
+	"Pacific/Auckland",               // (GMT+13:00) Pacific/Auckland
+	"Pacific/Enderbury",              // (GMT+13:00) Pacific/Enderbury
+	"Pacific/Fiji",                   // (GMT+12:00) Pacific/Fiji
+	"Pacific/Tongatapu",              // (GMT+13:00) Pacific/Tongatapu
+	"Asia/Kamchatka",                 // (GMT+12:00) Asia/Kamchatka
+	"Pacific/Norfolk",                // (GMT+12:00) Pacific/Norfolk
+	"Australia/Lord_Howe",            // (GMT+11:00) Australia/Lord_Howe
+	"Australia/Sydney",               // (GMT+11:00) Australia/Sydney
+	"Pacific/Guadalcanal",            // (GMT+11:00) Pacific/Guadalcanal
+	"Australia/Adelaide",             // (GMT+10:30) Australia/Adelaide
+	"Australia/Darwin",               // (GMT+09:30) Australia/Darwin
+	"Asia/Seoul",                     // (GMT+09:00) Asia/Seoul
+	"Asia/Tokyo",                     // (GMT+09:00) Asia/Tokyo
+	"Asia/Hong_Kong",                 // (GMT+08:00) Asia/Hong_Kong
+	"Asia/Kuala_Lumpur",              // (GMT+08:00) Asia/Kuala_Lumpur
+	"Asia/Manila",                    // (GMT+08:00) Asia/Manila
+	"Asia/Shanghai",                  // (GMT+08:00) Asia/Shanghai
+	"Asia/Singapore",                 // (GMT+08:00) Asia/Singapore
+	"Asia/Taipei",                    // (GMT+08:00) Asia/Taipei
+	"Australia/Perth",                // (GMT+08:00) Australia/Perth
+	"Asia/Bangkok",                   // (GMT+07:00) Asia/Bangkok
+	"Asia/Ho_Chi_Minh",               // (GMT+07:00) Asia/Ho_Chi_Minh
+	"Asia/Jakarta",                   // (GMT+07:00) Asia/Jakarta
+	"Asia/Rangoon",                   // (GMT+06:30) Asia/Rangoon
+	"Asia/Dhaka",                     // (GMT+06:00) Asia/Dhaka
+	"Asia/Yekaterinburg",             // (GMT+05:00) Asia/Yekaterinburg
+	"Asia/Kathmandu",                 // (GMT+05:45) Asia/Kathmandu
+	"Asia/Colombo",                   // (GMT+05:30) Asia/Colombo
+	"Asia/Kolkata",                   // (GMT+05:30) Asia/Kolkata
+	"Asia/Karachi",                   // (GMT+05:00) Asia/Karachi
+	"Asia/Tashkent",                  // (GMT+05:00) Asia/Tashkent
+	"Asia/Kabul",                     // (GMT+04:30) Asia/Kabul
+	"Asia/Dubai",                     // (GMT+04:00) Asia/Dubai
+	"Asia/Tbilisi",                   // (GMT+04:00) Asia/Tbilisi
+	"Europe/Moscow",                  // (GMT+03:00) Europe/Moscow
+	"Asia/Tehran",                    // (GMT+03:30) Asia/Tehran
+	"Africa/Nairobi",                 // (GMT+03:00) Africa/Nairobi
+	"Asia/Baghdad",                   // (GMT+03:00) Asia/Baghdad
+	"Asia/Kuwait",                    // (GMT+03:00) Asia/Kuwait
+	"Asia/Riyadh",                    // (GMT+03:00) Asia/Riyadh
+	"Europe/Minsk",                   // (GMT+03:00) Europe/Minsk
+	"Africa/Cairo",                   // (GMT+02:00) Africa/Cairo
+	"Africa/Johannesburg",            // (GMT+02:00) Africa/Johannesburg
+	"Asia/Jerusalem",                 // (GMT+02:00) Asia/Jerusalem
+	"Europe/Athens",                  // (GMT+02:00) Europe/Athens
+	"Europe/Bucharest",               // (GMT+02:00) Europe/Bucharest
+	"Europe/Helsinki",                // (GMT+02:00) Europe/Helsinki
+	"Europe/Istanbul",                // (GMT+03:00) Europe/Istanbul
+	"Africa/Algiers",                 // (GMT+01:00) Africa/Algiers
+	"Europe/Amsterdam",               // (GMT+01:00) Europe/Amsterdam
+	"Europe/Berlin",                  // (GMT+01:00) Europe/Berlin
+	"Europe/Brussels",                // (GMT+01:00) Europe/Brussels
+	"Europe/Paris",                   // (GMT+01:00) Europe/Paris
+	"Europe/Prague",                  // (GMT+01:00) Europe/Prague
+	"Europe/Rome",                    // (GMT+01:00) Europe/Rome
+	"Europe/Dublin",                  // (GMT+00:00) Europe/Dublin
+	"Europe/Lisbon",                  // (GMT+00:00) Europe/Lisbon
+	"Europe/London",                  // (GMT+00:00) Europe/London
+	"Atlantic/Cape_Verde",            // (GMT-01:00) Atlantic/Cape_Verde
+	"America/Sao_Paulo",              // (GMT-03:00) America/Sao_Paulo
+	"Atlantic/South_Georgia",         // (GMT-02:00) Atlantic/South_Georgia
+	"America/Argentina/Buenos_Aires", // (GMT-03:00) America/Argentina/Buenos_Aires
+	"America/Santiago",               // (GMT-03:00) America/Santiago
+	"America/St_Johns",               // (GMT-03:30) America/St_Johns
+	"America/Halifax",                // (GMT-04:00) America/Halifax
+	"America/Puerto_Rico",            // (GMT-04:00) America/Puerto_Rico
+	"Atlantic/Bermuda",               // (GMT-04:00) Atlantic/Bermuda
+	"America/Caracas",                // (GMT-04:00) America/Caracas
+	"America/Bogota",                 // (GMT-05:00) America/Bogota
+	"America/Indiana/Indianapolis",   // (GMT-05:00) America/Indiana/Indianapolis
+	"America/Lima",                   // (GMT-05:00) America/Lima
+	"America/New_York",               // (GMT-05:00) America/New_York
+	"America/Panama",                 // (GMT-05:00) America/Panama
+	"America/Chicago",                // (GMT-06:00) America/Chicago
+	"America/El_Salvador",            // (GMT-06:00) America/El_Salvador
+	"America/Mexico_City",            // (GMT-06:00) America/Mexico_City
+	"America/Denver",                 // (GMT-07:00) America/Denver
+	"America/Phoenix",                // (GMT-07:00) America/Phoenix
+	"America/Los_Angeles",            // (GMT-08:00) America/Los_Angeles
+	"America/Tijuana",                // (GMT-08:00) America/Tijuana
+	"America/Anchorage",              // (GMT-09:00) America/Anchorage
+	"Pacific/Honolulu",               // (GMT-10:00) Pacific/Honolulu
+	"Pacific/Niue",                   // (GMT-11:00) Pacific/Niue
+	"Pacific/Pago_Pago",              // (GMT-11:00) Pacific/Pago_Pago
 }
 
 var LocaleSidKeys = []string{
-	"af_ZA",
-	"ar_AE",
-	"ar_BH",
-	"ar_DZ",
-	"ar_EG",
-	"ar_IQ",
-	"ar_JO",
-	"ar_KW",
-	"ar_LB",
-	"ar_LY",
-	"ar_MA",
-	"ar_OM",
-	"ar_QA",
-	"ar_SA",
-	"ar_SD",
-	"ar_SY",
-	"ar_TN",
-	"ar_YE",
-	"az_AZ",
-	"be_BY",
-	"bg_BG",
-	"bn_BD",
-	"bn_IN",
-	"bs_BA",
-	"ca_ES",
-	"cs_CZ",
-	"cy_GB",
-	"da_DK",
-	"de_AT",
-	"de_BE",
-	"de_CH",
-	"de_DE",
-	"de_LU",
-	"dz_BT",
-	"el_GR",
-	"en_AG",
-	"en_AU",
-	"en_BB",
-	"en_BM",
-	"en_BS",
-	"en_BW",
-	"en_BZ",
-	"en_CA",
-	"en_CM",
-	"en_ER",
-	"en_FJ",
-	"en_FK",
-	"en_GB",
-	"en_GH",
-	"en_GI",
-	"en_GM",
-	"en_GY",
-	"en_HK",
-	"en_ID",
-	"en_IE",
-	"en_IN",
-	"en_JM",
-	"en_KE",
-	"en_KY",
-	"en_LR",
-	"en_MG",
-	"en_MU",
-	"en_MW",
-	"en_MY",
-	"en_NA",
-	"en_NG",
-	"en_NZ",
-	"en_PG",
-	"en_PH",
-	"en_PK",
-	"en_RW",
-	"en_SB",
-	"en_SC",
-	"en_SG",
-	"en_SH",
-	"en_SL",
-	"en_SX",
-	"en_SZ",
-	"en_TO",
-	"en_TT",
-	"en_TZ",
-	"en_UG",
-	"en_US",
-	"en_VU",
-	"en_WS",
-	"en_ZA",
-	"es_AR",
-	"es_BO",
-	"es_CL",
-	"es_CO",
-	"es_CR",
-	"es_CU",
-	"es_DO",
-	"es_EC",
-	"es_ES",
-	"es_GT",
-	"es_HN",
-	"es_MX",
-	"es_NI",
-	"es_PA",
-	"es_PE",
-	"es_PR",
-	"es_PY",
-	"es_SV",
-	"es_US",
-	"es_UY",
-	"es_VE",
-	"et_EE",
-	"eu_ES",
-	"fa_IR",
-	"fi_FI",
-	"fr_BE",
-	"fr_CA",
-	"fr_CH",
-	"fr_FR",
-	"fr_GN",
-	"fr_HT",
-	"fr_KM",
-	"fr_LU",
-	"fr_MC",
-	"fr_MR",
-	"fr_WF",
-	"ga_IE",
-	"gu_IN",
-	"hi_IN",
-	"hr_HR",
-	"hu_HU",
-	"hy_AM",
-	"in_ID",
-	"is_IS",
-	"it_CH",
-	"it_IT",
-	"iw_IL",
-	"ja_JP",
-	"ka_GE",
-	"kk_KZ",
-	"km_KH",
-	"kn_IN",
-	"ko_KP",
-	"ko_KR",
-	"ky_KG",
-	"lb_LU",
-	"lo_LA",
-	"lt_LT",
-	"lu_CD",
-	"lv_LV",
-	"mi_NZ",
-	"mk_MK",
-	"ml_IN",
-	"mr_IN",
-	"ms_BN",
-	"ms_MY",
-	"mt_MT",
-	"my_MM",
-	"ne_NP",
-	"nl_AW",
-	"nl_BE",
-	"nl_NL",
-	"nl_SR",
-	"no_NO",
-	"pl_PL",
-	"ps_AF",
-	"pt_AO",
-	"pt_BR",
-	"pt_CV",
-	"pt_MZ",
-	"pt_PT",
-	"pt_ST",
-	"rm_CH",
-	"rn_BI",
-	"ro_MD",
-	"ro_RO",
-	"ru_KZ",
-	"ru_RU",
-	"sh_BA",
-	"sh_CS",
-	"sh_ME",
-	"sh_ME_USD",
-	"sk_SK",
-	"sl_SI",
-	"so_DJ",
-	"so_SO",
-	"sq_AL",
-	"sr_BA",
-	"sr_CS",
-	"sr_RS",
-	"sv_SE",
-	"sw_KE",
-	"ta_IN",
-	"ta_LK",
-	"te_IN",
-	"tg_TJ",
-	"th_TH",
-	"ti_ET",
-	"tl_PH",
-	"tr_TR",
-	"uk_UA",
-	"ur_PK",
-	"uz_LATN_UZ",
-	"vi_VN",
-	"xh_ZA",
-	"yo_BJ",
-	"zh_CN",
-	"zh_CN_PINYIN",
-	"zh_CN_STROKE",
-	"zh_HK",
-	"zh_HK_STROKE",
-	"zh_MO",
-	"zh_SG",
-	"zh_TW",
-	"zh_TW_STROKE",
-	"zu_ZA",
+	"af_ZA",        // Afrikaans (South Africa)
+	"ar_AE",        // Arabic (United Arab Emirates)
+	"ar_BH",        // Arabic (Bahrain)
+	"ar_DZ",        // Arabic (Algeria)
+	"ar_EG",        // Arabic (Egypt)
+	"ar_IQ",        // Arabic (Iraq)
+	"ar_JO",        // Arabic (Jordan)
+	"ar_KW",        // Arabic (Kuwait)
+	"ar_LB",        // Arabic (Lebanon)
+	"ar_LY",        // Arabic (Libya)
+	"ar_MA",        // Arabic (Morocco)
+	"ar_OM",        // Arabic (Oman)
+	"ar_QA",        // Arabic (Qatar)
+	"ar_SA",        // Arabic (Saudi Arabia)
+	"ar_SD",        // Arabic (Sudan)
+	"ar_SY",        // Arabic (Syria)
+	"ar_TN",        // Arabic (Tunisia)
+	"ar_YE",        // Arabic (Yemen)
+	"az_AZ",        // Azerbaijani (Azerbaijan)
+	"be_BY",        // Belarusian (Belarus)
+	"bg_BG",        // Bulgarian (Bulgaria)
+	"bn_BD",        // Bangla (Bangladesh)
+	"bn_IN",        // Bangla (India)
+	"bs_BA",        // Bosnian (Bosnia & Herzegovina)
+	"ca_ES",        // Catalan (Spain)
+	"cs_CZ",        // Czech (Czechia)
+	"cy_GB",        // Welsh (United Kingdom)
+	"da_DK",        // Danish (Denmark)
+	"de_AT",        // German (Austria)
+	"de_BE",        // German (Belgium)
+	"de_CH",        // German (Switzerland)
+	"de_DE",        // German (Germany)
+	"de_LU",        // German (Luxembourg)
+	"dz_BT",        // Dzongkha (Bhutan)
+	"el_GR",        // Greek (Greece)
+	"en_AG",        // English (Antigua & Barbuda)
+	"en_AU",        // English (Australia)
+	"en_BB",        // English (Barbados)
+	"en_BM",        // English (Bermuda)
+	"en_BS",        // English (Bahamas)
+	"en_BW",        // English (Botswana)
+	"en_BZ",        // English (Belize)
+	"en_CA",        // English (Canada)
+	"en_CM",        // English (Cameroon)
+	"en_ER",        // English (Eritrea)
+	"en_FJ",        // English (Fiji)
+	"en_FK",        // English (Falkland Islands)
+	"en_GB",        // English (United Kingdom)
+	"en_GH",        // English (Ghana)
+	"en_GI",        // English (Gibraltar)
+	"en_GM",        // English (Gambia)
+	"en_GY",        // English (Guyana)
+	"en_HK",        // English (Hong Kong SAR China)
+	"en_ID",        // English (Indonesia)
+	"en_IE",        // English (Ireland)
+	"en_IN",        // English (India)
+	"en_JM",        // English (Jamaica)
+	"en_KE",        // English (Kenya)
+	"en_KY",        // English (Cayman Islands)
+	"en_LR",        // English (Liberia)
+	"en_MG",        // English (Madagascar)
+	"en_MU",        // English (Mauritius)
+	"en_MW",        // English (Malawi)
+	"en_MY",        // English (Malaysia)
+	"en_NA",        // English (Namibia)
+	"en_NG",        // English (Nigeria)
+	"en_NZ",        // English (New Zealand)
+	"en_PG",        // English (Papua New Guinea)
+	"en_PH",        // English (Philippines)
+	"en_PK",        // English (Pakistan)
+	"en_RW",        // English (Rwanda)
+	"en_SB",        // English (Solomon Islands)
+	"en_SC",        // English (Seychelles)
+	"en_SG",        // English (Singapore)
+	"en_SH",        // English (St. Helena)
+	"en_SL",        // English (Sierra Leone)
+	"en_SX",        // English (Sint Maarten)
+	"en_SZ",        // English (Swaziland)
+	"en_TO",        // English (Tonga)
+	"en_TT",        // English (Trinidad & Tobago)
+	"en_TZ",        // English (Tanzania)
+	"en_UG",        // English (Uganda)
+	"en_US",        // English (United States)
+	"en_VU",        // English (Vanuatu)
+	"en_WS",        // English (Samoa)
+	"en_ZA",        // English (South Africa)
+	"es_AR",        // Spanish (Argentina)
+	"es_BO",        // Spanish (Bolivia)
+	"es_CL",        // Spanish (Chile)
+	"es_CO",        // Spanish (Colombia)
+	"es_CR",        // Spanish (Costa Rica)
+	"es_CU",        // Spanish (Cuba)
+	"es_DO",        // Spanish (Dominican Republic)
+	"es_EC",        // Spanish (Ecuador)
+	"es_ES",        // Spanish (Spain)
+	"es_GT",        // Spanish (Guatemala)
+	"es_HN",        // Spanish (Honduras)
+	"es_MX",        // Spanish (Mexico)
+	"es_NI",        // Spanish (Nicaragua)
+	"es_PA",        // Spanish (Panama)
+	"es_PE",        // Spanish (Peru)
+	"es_PR",        // Spanish (Puerto Rico)
+	"es_PY",        // Spanish (Paraguay)
+	"es_SV",        // Spanish (El Salvador)
+	"es_US",        // Spanish (United States)
+	"es_UY",        // Spanish (Uruguay)
+	"es_VE",        // Spanish (Venezuela)
+	"et_EE",        // Estonian (Estonia)
+	"eu_ES",        // Basque (Spain)
+	"fa_IR",        // Persian (Iran)
+	"fi_FI",        // Finnish (Finland)
+	"fr_BE",        // French (Belgium)
+	"fr_CA",        // French (Canada)
+	"fr_CH",        // French (Switzerland)
+	"fr_FR",        // French (France)
+	"fr_GN",        // French (Guinea)
+	"fr_HT",        // French (Haiti)
+	"fr_KM",        // French (Comoros)
+	"fr_LU",        // French (Luxembourg)
+	"fr_MC",        // French (Monaco)
+	"fr_MR",        // French (Mauritania)
+	"fr_WF",        // French (Wallis & Futuna)
+	"ga_IE",        // Irish (Ireland)
+	"gu_IN",        // Gujarati (India)
+	"hi_IN",        // Hindi (India)
+	"hr_HR",        // Croatian (Croatia)
+	"hu_HU",        // Hungarian (Hungary)
+	"hy_AM",        // Armenian (Armenia)
+	"in_ID",        // Indonesian (Indonesia)
+	"is_IS",        // Icelandic (Iceland)
+	"it_CH",        // Italian (Switzerland)
+	"it_IT",        // Italian (Italy)
+	"iw_IL",        // Hebrew (Israel)
+	"ja_JP",        // Japanese (Japan)
+	"ka_GE",        // Georgian (Georgia)
+	"kk_KZ",        // Kazakh (Kazakhstan)
+	"km_KH",        // Khmer (Cambodia)
+	"kn_IN",        // Kannada (India)
+	"ko_KP",        // Korean (North Korea)
+	"ko_KR",        // Korean (South Korea)
+	"ky_KG",        // Kyrgyz (Kyrgyzstan)
+	"lb_LU",        // Luxembourgish (Luxembourg)
+	"lo_LA",        // Lao (Laos)
+	"lt_LT",        // Lithuanian (Lithuania)
+	"lu_CD",        // Luba-Katanga (Congo - Kinshasa)
+	"lv_LV",        // Latvian (Latvia)
+	"mi_NZ",        // Maori (New Zealand)
+	"mk_MK",        // Macedonian (Macedonia)
+	"ml_IN",        // Malayalam (India)
+	"mr_IN",        // Marathi (India)
+	"ms_BN",        // Malay (Brunei)
+	"ms_MY",        // Malay (Malaysia)
+	"mt_MT",        // Maltese (Malta)
+	"my_MM",        // Burmese (Myanmar (Burma))
+	"ne_NP",        // Nepali (Nepal)
+	"nl_AW",        // Dutch (Aruba)
+	"nl_BE",        // Dutch (Belgium)
+	"nl_NL",        // Dutch (Netherlands)
+	"nl_SR",        // Dutch (Suriname)
+	"no_NO",        // Norwegian Bokmål (Norway)
+	"pl_PL",        // Polish (Poland)
+	"ps_AF",        // Pashto (Afghanistan)
+	"pt_AO",        // Portuguese (Angola)
+	"pt_BR",        // Portuguese (Brazil)
+	"pt_CV",        // Portuguese (Cape Verde)
+	"pt_MZ",        // Portuguese (Mozambique)
+	"pt_PT",        // Portuguese (Portugal)
+	"pt_ST",        // Portuguese (São Tomé & Príncipe)
+	"rm_CH",        // Romansh (Switzerland)
+	"rn_BI",        // Rundi (Burundi)
+	"ro_MD",        // Romanian (Moldova)
+	"ro_RO",        // Romanian (Romania)
+	"ru_KZ",        // Russian (Kazakhstan)
+	"ru_RU",        // Russian (Russia)
+	"sh_BA",        // Serbo-Croatian (Bosnia & Herzegovina)
+	"sh_CS",        // Serbian (Latin) (Serbia and Montenegro)
+	"sh_ME",        // Serbo-Croatian (Montenegro)
+	"sh_ME_USD",    // Serbian (Latin) (Montenegro) - USD
+	"sk_SK",        // Slovak (Slovakia)
+	"sl_SI",        // Slovenian (Slovenia)
+	"so_DJ",        // Somali (Djibouti)
+	"so_SO",        // Somali (Somalia)
+	"sq_AL",        // Albanian (Albania)
+	"sr_BA",        // Serbian (Bosnia & Herzegovina)
+	"sr_CS",        // Serbian (Cyrillic) (Serbia and Montenegro)
+	"sr_RS",        // Serbian (Serbia)
+	"sv_SE",        // Swedish (Sweden)
+	"sw_KE",        // Swahili (Kenya)
+	"ta_IN",        // Tamil (India)
+	"ta_LK",        // Tamil (Sri Lanka)
+	"te_IN",        // Telugu (India)
+	"tg_TJ",        // Tajik (Tajikistan)
+	"th_TH",        // Thai (Thailand)
+	"ti_ET",        // Tigrinya (Ethiopia)
+	"tl_PH",        // Filipino (Philippines)
+	"tr_TR",        // Turkish (Turkey)
+	"uk_UA",        // Ukrainian (Ukraine)
+	"ur_PK",        // Urdu (Pakistan)
+	"uz_LATN_UZ",   // Uzbek (Latin, Uzbekistan)
+	"vi_VN",        // Vietnamese (Vietnam)
+	"xh_ZA",        // Xhosa (South Africa)
+	"yo_BJ",        // Yoruba (Benin)
+	"zh_CN",        // Chinese (China)
+	"zh_CN_PINYIN", // Chinese (China)
+	"zh_CN_STROKE", // Chinese (China) - Stroke
+	"zh_HK",        // Chinese (Hong Kong SAR China)
+	"zh_HK_STROKE", // Chinese (Hong Kong SAR China) - Stroke
+	"zh_MO",        // Chinese (Macau SAR China)
+	"zh_SG",        // Chinese (Singapore)
+	"zh_TW",        // Chinese (Taiwan)
+	"zh_TW_STROKE", // Chinese (Taiwan) - Stroke
+	"zu_ZA",        // Zulu (South Africa)
 }
 
 var EmailEncodingKeys = []string{
-	"UTF-8",
-	"ISO-8859-1",
-	"ks_c_5601-1987",
-	"Big5",
-	"GB2312",
-	"Big5-HKSCS",
+	"UTF-8",          // Unicode (UTF-8)
+	"ISO-8859-1",     // General US & Western Europe (ISO-8859-1, ISO-LATIN-1)
+	"ks_c_5601-1987", // Korean (ks_c_5601-1987)
+	"Big5",           // Chinese Traditional (Big5)
+	"GB2312",         // Chinese Simplified (GB2312)
+	"Big5-HKSCS",     // Chinese Traditional Big5-HKSCS
 }
 
 var LanguageLocaleKeys = []string{
-	"no",
-	"es_MX",
-	"ru",
-	"fi",
-	"th",
-	"da",
-	"nl_NL",
-	"pt_BR",
-	"zh_CN",
-	"zh_TW",
-	"ko",
-	"sv",
-	"ja",
-	"it",
-	"fr",
-	"es",
-	"de",
-	"en_US",
-}
-
-var LicenseDefinitionKeys = []string{
-	"SFDC",
-	"AUL",
-	"PID_Customer_Community",
-	"PID_Customer_Community_Plus",
-	"PID_Identity_User",
-	"PID_XOrg_Proxy_User",
-	"PID_STRATEGIC_PRM",
-	"FDC_ISV_SUB",
-	"PLATFORM_PORTAL",
-	"PID_Partner_Community",
-	"HIGH_VOLUME_PORTAL",
-	"PID_Customer_Portal_Standard",
-	"PID_Partner_Community_Login",
-	"PID_WCUSER",
-	"PID_STANDARD_PRM",
-	"PID_FDC_FREE",
-	"PID_External_Identity",
-	"PID_Customer_Community_Login",
-	"PID_Customer_Portal_Basic",
-	"FDC_SUB",
-	"PID_Customer_Community_Plus_Login",
-	"CSN_External_User",
-	"CSN_User",
-	"PID_CpqIntegration",
-	"High_Volume_Customer_Portal_User",
-	"Platform_Portal_User",
-	"INSIGHTS_INTEGRATION_USER",
-	"CLOUD_INTEGRATION_USER",
-	"PID_External_Apps_Login",
+	"no",    // Norwegian
+	"es_MX", // Spanish (Mexico)
+	"ru",    // Russian
+	"fi",    // Finnish
+	"th",    // Thai
+	"da",    // Danish
+	"nl_NL", // Dutch
+	"pt_BR", // Portuguese (Brazil)
+	"zh_CN", // Chinese (Simplified)
+	"zh_TW", // Chinese (Traditional)
+	"ko",    // Korean
+	"sv",    // Swedish
+	"ja",    // Japanese
+	"it",    // Italian
+	"fr",    // French
+	"es",    // Spanish
+	"de",    // German
+	"en_US", // English
 }
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func addressAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"street": schema.StringAttribute{
+			Description: fmt.Sprintf("Street of the %s address.", kind),
+			Optional:    true,
+		},
+		"city": schema.StringAttribute{
+			Description: fmt.Sprintf("City of the %s address.", kind),
+			Optional:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: fmt.Sprintf("State or province of the %s address.", kind),
+			Optional:    true,
+		},
+		"postal_code": schema.StringAttribute{
+			Description: fmt.Sprintf("Postal code of the %s address.", kind),
+			Optional:    true,
+		},
+		"country": schema.StringAttribute{
+			Description: fmt.Sprintf("Country of the %s address.", kind),
+			Optional:    true,
+		},
+	}
+}
+
+type addressModel struct {
+	Street     types.String `tfsdk:"street"`
+	City       types.String `tfsdk:"city"`
+	State      types.String `tfsdk:"state"`
+	PostalCode types.String `tfsdk:"postal_code"`
+	Country    types.String `tfsdk:"country"`
+}
+
+// flattenAddress returns nil when the address is empty and wasn't in config, to avoid diffs
+func flattenAddress(prior *addressModel, street, city, state, postalCode, country *string) *addressModel {
+	if prior == nil && street == nil && city == nil && state == nil && postalCode == nil && country == nil {
+		return nil
+	}
+	return &addressModel{
+		Street:     types.StringPointerValue(street),
+		City:       types.StringPointerValue(city),
+		State:      types.StringPointerValue(state),
+		PostalCode: types.StringPointerValue(postalCode),
+		Country:    types.StringPointerValue(country),
+	}
+}
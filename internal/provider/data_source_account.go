@@ -5,8 +5,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -56,31 +59,123 @@ func (d *accountDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Description: "Website URL.",
 				Computed:    true,
 			},
+			"description": schema.StringAttribute{
+				Description: "Text description of the account.",
+				Computed:    true,
+			},
+			"annual_revenue": schema.Float64Attribute{
+				Description: "Estimated annual revenue of the account.",
+				Computed:    true,
+			},
+			"number_of_employees": schema.Int64Attribute{
+				Description: "Number of employees working at the account.",
+				Computed:    true,
+			},
+			"rating": schema.StringAttribute{
+				Description: "The account's prospect rating (e.g., Hot, Warm, Cold).",
+				Computed:    true,
+			},
+			"ownership": schema.StringAttribute{
+				Description: "Ownership type for the account (e.g., Public, Private, Subsidiary, Other).",
+				Computed:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "ID of the user who owns the account.",
+				Computed:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "ID of the parent account.",
+				Computed:    true,
+			},
+			"record_type_id": schema.StringAttribute{
+				Description: "ID of the account's record type.",
+				Computed:    true,
+			},
+			"billing_address": schema.SingleNestedAttribute{
+				Description: "Billing address of the account.",
+				Computed:    true,
+				Attributes:  addressDataAttributes("billing"),
+			},
+			"shipping_address": schema.SingleNestedAttribute{
+				Description: "Shipping address of the account.",
+				Computed:    true,
+				Attributes:  addressDataAttributes("shipping"),
+			},
+			"custom_fields": schema.MapAttribute{
+				Description: "Map of custom field API names (ending in __c) to values of the account, as strings.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func addressDataAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"street": schema.StringAttribute{
+			Description: fmt.Sprintf("Street of the %s address.", kind),
+			Computed:    true,
+		},
+		"city": schema.StringAttribute{
+			Description: fmt.Sprintf("City of the %s address.", kind),
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: fmt.Sprintf("State or province of the %s address.", kind),
+			Computed:    true,
+		},
+		"postal_code": schema.StringAttribute{
+			Description: fmt.Sprintf("Postal code of the %s address.", kind),
+			Computed:    true,
+		},
+		"country": schema.StringAttribute{
+			Description: fmt.Sprintf("Country of the %s address.", kind),
+			Computed:    true,
 		},
 	}
 }
 
 type accountDataModel struct {
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	AccountNumber types.String `tfsdk:"account_number"`
-	Type          types.String `tfsdk:"type"`
-	Industry      types.String `tfsdk:"industry"`
-	Phone         types.String `tfsdk:"phone"`
-	Website       types.String `tfsdk:"website"`
+	Id                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	AccountNumber     types.String  `tfsdk:"account_number"`
+	Type              types.String  `tfsdk:"type"`
+	Industry          types.String  `tfsdk:"industry"`
+	Phone             types.String  `tfsdk:"phone"`
+	Website           types.String  `tfsdk:"website"`
+	Description       types.String  `tfsdk:"description"`
+	AnnualRevenue     types.Float64 `tfsdk:"annual_revenue"`
+	NumberOfEmployees types.Int64   `tfsdk:"number_of_employees"`
+	Rating            types.String  `tfsdk:"rating"`
+	Ownership         types.String  `tfsdk:"ownership"`
+	OwnerId           types.String  `tfsdk:"owner_id"`
+	ParentId          types.String  `tfsdk:"parent_id"`
+	RecordTypeId      types.String  `tfsdk:"record_type_id"`
+	BillingAddress    *addressModel `tfsdk:"billing_address"`
+	ShippingAddress   *addressModel `tfsdk:"shipping_address"`
+	CustomFields      types.Map     `tfsdk:"custom_fields"`
 }
 
 type accountQueryResponse struct {
 	sobjects.BaseQuery
-	Records []struct {
-		Id            string `json:"Id"`
-		Name          string `json:"Name"`
-		AccountNumber string `json:"AccountNumber"`
-		Type          string `json:"Type"`
-		Industry      string `json:"Industry"`
-		Phone         string `json:"Phone"`
-		Website       string `json:"Website"`
+	Records []customAccountRecord
+}
+
+// customAccountRecord is an Account returned by a query, with its Id alongside the writable fields
+type customAccountRecord struct {
+	Id string `json:"Id"`
+	customAccount
+}
+
+func (a *customAccountRecord) UnmarshalJSON(data []byte) error {
+	var record struct {
+		Id string `json:"Id"`
+	}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
 	}
+	a.Id = record.Id
+	return a.customAccount.UnmarshalJSON(data)
 }
 
 func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -91,9 +186,33 @@ func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	desc, err := describeSObject(d.client, "Account")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Describing Account", err.Error())
+		return
+	}
+	fieldNames := []string{
+		"Id", "Name", "AccountNumber", "Type", "Industry", "Phone", "Website", "Description",
+		"AnnualRevenue", "NumberOfEmployees", "Rating", "Ownership", "OwnerId", "ParentId",
+		"BillingStreet", "BillingCity", "BillingState", "BillingPostalCode", "BillingCountry",
+		"ShippingStreet", "ShippingCity", "ShippingState", "ShippingPostalCode", "ShippingCountry",
+	}
+	// RecordTypeId only exists once the org has record types on Account
+	if len(desc.RecordTypeInfos) > 1 {
+		fieldNames = append(fieldNames, "RecordTypeId")
+	}
+	customFields := make(map[string]*force.SObjectField)
+	for _, field := range desc.Fields {
+		// compound fields can't be selected alongside their components
+		if field.Custom && field.Type != "address" && field.Type != "location" {
+			fieldNames = append(fieldNames, field.Name)
+			customFields[field.Name] = field
+		}
+	}
+
 	var query accountQueryResponse
-	nameFilter := fmt.Sprintf("Name = '%s'", data.Name.ValueString())
-	if err := d.client.Query(force.BuildQuery("Id, Name, AccountNumber, Type, Industry, Phone, Website", "Account", []string{nameFilter}), &query); err != nil {
+	nameFilter := fmt.Sprintf("Name = '%s'", soqlEscape(data.Name.ValueString()))
+	if err := d.client.Query(force.BuildQuery(strings.Join(fieldNames, ", "), "Account", []string{nameFilter}), &query); err != nil {
 		resp.Diagnostics.AddError("Error Getting Account", err.Error())
 		return
 	}
@@ -105,12 +224,46 @@ func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	record := query.Records[0]
 	data.Id = types.StringValue(record.Id)
 	data.Name = types.StringValue(record.Name)
-	data.AccountNumber = types.StringValue(record.AccountNumber)
-	data.Type = types.StringValue(record.Type)
-	data.Industry = types.StringValue(record.Industry)
-	data.Phone = types.StringValue(record.Phone)
-	data.Website = types.StringValue(record.Website)
+	data.AccountNumber = types.StringPointerValue(record.AccountNumber)
+	data.Type = types.StringPointerValue(record.Type)
+	data.Industry = types.StringPointerValue(record.Industry)
+	data.Phone = types.StringPointerValue(record.Phone)
+	data.Website = types.StringPointerValue(record.Website)
+	data.Description = types.StringPointerValue(record.Description)
+	data.AnnualRevenue = types.Float64PointerValue(record.AnnualRevenue)
+	data.NumberOfEmployees = types.Int64PointerValue(record.NumberOfEmployees)
+	data.Rating = types.StringPointerValue(record.Rating)
+	data.Ownership = types.StringPointerValue(record.Ownership)
+	data.OwnerId = types.StringPointerValue(record.OwnerId)
+	data.ParentId = types.StringPointerValue(record.ParentId)
+	data.RecordTypeId = types.StringPointerValue(record.RecordTypeId)
+	data.BillingAddress = &addressModel{
+		Street:     types.StringPointerValue(record.BillingStreet),
+		City:       types.StringPointerValue(record.BillingCity),
+		State:      types.StringPointerValue(record.BillingState),
+		PostalCode: types.StringPointerValue(record.BillingPostalCode),
+		Country:    types.StringPointerValue(record.BillingCountry),
+	}
+	data.ShippingAddress = &addressModel{
+		Street:     types.StringPointerValue(record.ShippingStreet),
+		City:       types.StringPointerValue(record.ShippingCity),
+		State:      types.StringPointerValue(record.ShippingState),
+		PostalCode: types.StringPointerValue(record.ShippingPostalCode),
+		Country:    types.StringPointerValue(record.ShippingCountry),
+	}
+
+	values := make(map[string]attr.Value, len(customFields))
+	for name, field := range customFields {
+		raw, _ := lookupSObjectField(record.CustomFields, name)
+		v, err := sobjectValueFromInterface(raw, types.StringNull(), field)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Account", fmt.Sprintf("%s: %s", name, err))
+			return
+		}
+		values[name] = v
+	}
+	data.CustomFields = types.MapValueMust(types.StringType, values)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-} 
\ No newline at end of file
+}
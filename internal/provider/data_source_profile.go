@@ -6,12 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/nimajalali/go-force/force"
-	"github.com/nimajalali/go-force/sobjects"
 )
 
 type profileDataSource struct {
@@ -36,21 +38,45 @@ func (d *profileDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Description: "The name of the profile.",
 				Required:    true,
 			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Computed:    true,
+			},
+			"user_license_id": schema.StringAttribute{
+				Description: "ID of the UserLicense associated with this profile.",
+				Computed:    true,
+			},
+			"user_type": schema.StringAttribute{
+				Description: "The category of user license of the profile, for example Standard or PowerPartner.",
+				Computed:    true,
+			},
+			"is_custom": schema.BoolAttribute{
+				Description: "Whether the profile is a custom profile rather than a standard one shipped by Salesforce.",
+				Computed:    true,
+			},
+			"permission_names": schema.ListAttribute{
+				Description: "Permissions to read into permissions, in the same format as the profile resource: the field name without the 'Permissions' prefix, for example ApiEnabled.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"permissions": schema.MapAttribute{
+				Description: "Map of the permissions requested in permission_names to whether the profile grants them, suitable for the permissions of a profile resource.",
+				Computed:    true,
+				ElementType: types.BoolType,
+			},
 		},
 	}
 }
 
 type profileDataModel struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-}
-
-type profileQueryResponse struct {
-	sobjects.BaseQuery
-	Records []struct {
-		Id   string `json:"Id"`
-		Name string `json:"Name"`
-	}
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	UserLicenseId   types.String `tfsdk:"user_license_id"`
+	UserType        types.String `tfsdk:"user_type"`
+	IsCustom        types.Bool   `tfsdk:"is_custom"`
+	PermissionNames types.List   `tfsdk:"permission_names"`
+	Permissions     types.Map    `tfsdk:"permissions"`
 }
 
 func (d *profileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -61,20 +87,79 @@ func (d *profileDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	var query profileQueryResponse
-	nameFilter := fmt.Sprintf("Name = '%s'", data.Name.ValueString())
-	if err := d.client.Query(force.BuildQuery("Id, Name", "Profile", []string{nameFilter}), &query); err != nil {
+	var permissionNames []string
+	if !data.PermissionNames.IsNull() {
+		resp.Diagnostics.Append(data.PermissionNames.ElementsAs(ctx, &permissionNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	fieldNames := []string{"Id", "Name", "Description", "UserLicenseId", "UserType"}
+	if len(permissionNames) > 0 {
+		desc, err := describeSObject(d.client, "Profile")
+		if err != nil {
+			resp.Diagnostics.AddError("Error Describing Profile", err.Error())
+			return
+		}
+		describeFields := sobjectFieldsByName(desc)
+		for i, name := range permissionNames {
+			field, ok := describeFields[strings.ToLower("Permissions"+name)]
+			if !ok || field.Type != "boolean" {
+				resp.Diagnostics.AddAttributeError(path.Root("permission_names").AtListIndex(i), "Invalid permission", fmt.Sprintf("Profile has no permission named %s", name))
+				continue
+			}
+			fieldNames = append(fieldNames, field.Name)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	nameFilter := fmt.Sprintf("Name = '%s'", soqlEscape(data.Name.ValueString()))
+	records, err := queryAllRecords[map[string]interface{}](d.client, force.BuildQuery(strings.Join(fieldNames, ", "), "Profile", []string{nameFilter}))
+	if err != nil {
 		resp.Diagnostics.AddError("Error Getting Profile", err.Error())
 		return
 	}
-	if len(query.Records) == 0 {
+	if len(records) == 0 {
 		resp.Diagnostics.AddError("Error Getting Profile", fmt.Sprintf("No Profile where %s", nameFilter))
 		return
 	}
 
-	record := query.Records[0]
-	data.Id = types.StringValue(record.Id)
-	data.Name = types.StringValue(record.Name)
+	record := records[0]
+	stringField := func(name string) types.String {
+		if v, ok := lookupSObjectField(record, name); ok {
+			if s, ok := v.(string); ok {
+				return types.StringValue(s)
+			}
+		}
+		return types.StringNull()
+	}
+	data.Id = stringField("Id")
+	data.Name = stringField("Name")
+	data.Description = stringField("Description")
+	data.UserLicenseId = stringField("UserLicenseId")
+	data.UserType = stringField("UserType")
+
+	permissions := make(map[string]attr.Value, len(permissionNames))
+	for _, name := range permissionNames {
+		v, _ := lookupSObjectField(record, "Permissions"+name)
+		b, _ := v.(bool)
+		permissions[name] = types.BoolValue(b)
+	}
+	data.Permissions = types.MapValueMust(types.BoolType, permissions)
+
+	// profiles have no custom flag of their own, it's on the permission set each profile owns
+	ownedQuery := fmt.Sprintf("SELECT IsCustom FROM PermissionSet WHERE IsOwnedByProfile = true AND ProfileId = '%s'", soqlEscape(data.Id.ValueString()))
+	owned, err := queryAllRecords[struct {
+		IsCustom bool `json:"IsCustom"`
+	}](d.client, ownedQuery)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Profile", err.Error())
+		return
+	}
+	data.IsCustom = types.BoolValue(len(owned) > 0 && owned[0].IsCustom)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
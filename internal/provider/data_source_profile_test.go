@@ -21,6 +21,8 @@ func TestAccDataSourceProfile_basic(t *testing.T) {
 				Config: testAccDataSourceProfile_basic("Chatter Free User"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.salesforce_profile.test", "id"),
+					resource.TestCheckResourceAttrSet("data.salesforce_profile.test", "user_license_id"),
+					resource.TestCheckResourceAttr("data.salesforce_profile.test", "is_custom", "false"),
 				),
 			},
 		},
@@ -34,3 +36,27 @@ data "salesforce_profile" "test" {
 }
 `, name)
 }
+
+func TestAccDataSourceProfile_permissions(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "salesforce_profile" "test" {
+  name             = "System Administrator"
+  permission_names = ["ApiEnabled", "ModifyAllData"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_profile.test", "permissions.%", "2"),
+					resource.TestCheckResourceAttr("data.salesforce_profile.test", "permissions.ApiEnabled", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_profile.test", "user_type", "Standard"),
+				),
+			},
+		},
+	})
+}
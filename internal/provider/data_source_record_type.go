@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type recordTypeDataSource struct {
+	client *force.ForceApi
+}
+
+var _ datasource.DataSource = &recordTypeDataSource{}
+
+func (d *recordTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_record_type"
+}
+
+func (d *recordTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Record Type Data Source for the Salesforce Provider, resolves a record type to its ID so that configurations don't hard-code IDs that differ between orgs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the record type.",
+				Computed:    true,
+			},
+			"sobject_type": schema.StringAttribute{
+				Description: "API name of the object of the record type, such as Account or Widget__c.",
+				Required:    true,
+			},
+			"developer_name": schema.StringAttribute{
+				Description: "The unique name of the record type on the object.",
+				Required:    true,
+			},
+			"namespace_prefix": schema.StringAttribute{
+				Description: "Namespace of the managed package of the record type, only needed when packages define record types with the same developer name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Label of the record type.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the record type.",
+				Computed:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the record type can be assigned to records.",
+				Computed:    true,
+			},
+			"business_process_id": schema.StringAttribute{
+				Description: "ID of the business process of the record type, for the objects with business processes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type recordTypeDataModel struct {
+	Id                types.String `tfsdk:"id"`
+	SobjectType       types.String `tfsdk:"sobject_type"`
+	DeveloperName     types.String `tfsdk:"developer_name"`
+	NamespacePrefix   types.String `tfsdk:"namespace_prefix"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	IsActive          types.Bool   `tfsdk:"is_active"`
+	BusinessProcessId types.String `tfsdk:"business_process_id"`
+}
+
+// recordTypeRecord is a RecordType returned by a query
+type recordTypeRecord struct {
+	Id                string  `json:"Id"`
+	SobjectType       string  `json:"SobjectType"`
+	DeveloperName     string  `json:"DeveloperName"`
+	NamespacePrefix   *string `json:"NamespacePrefix"`
+	Name              string  `json:"Name"`
+	Description       *string `json:"Description"`
+	IsActive          bool    `json:"IsActive"`
+	BusinessProcessId *string `json:"BusinessProcessId"`
+}
+
+// queryRecordTypes returns the record types of the object with the developer name, of every namespace
+func queryRecordTypes(client *force.ForceApi, sobjectType, developerName string) ([]recordTypeRecord, error) {
+	query := force.BuildQuery(
+		"Id, SobjectType, DeveloperName, NamespacePrefix, Name, Description, IsActive, BusinessProcessId",
+		"RecordType",
+		[]string{
+			fmt.Sprintf("SobjectType = '%s'", soqlEscape(sobjectType)),
+			fmt.Sprintf("DeveloperName = '%s'", soqlEscape(developerName)),
+		},
+	)
+	return queryAllRecords[recordTypeRecord](client, query)
+}
+
+func (d *recordTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data recordTypeDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := queryRecordTypes(d.client, data.SobjectType.ValueString(), data.DeveloperName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Record Type", err.Error())
+		return
+	}
+	if !data.NamespacePrefix.IsNull() {
+		var matching []recordTypeRecord
+		for _, record := range records {
+			if record.NamespacePrefix != nil && *record.NamespacePrefix == data.NamespacePrefix.ValueString() {
+				matching = append(matching, record)
+			}
+		}
+		records = matching
+	}
+	if len(records) == 0 {
+		resp.Diagnostics.AddError("Error Getting Record Type", fmt.Sprintf("No Record Type %s on %s", data.DeveloperName.ValueString(), data.SobjectType.ValueString()))
+		return
+	}
+	if len(records) > 1 {
+		resp.Diagnostics.AddError("Error Getting Record Type", fmt.Sprintf("%d Record Types %s on %s, set namespace_prefix to choose one", len(records), data.DeveloperName.ValueString(), data.SobjectType.ValueString()))
+		return
+	}
+
+	record := records[0]
+	data.Id = types.StringValue(record.Id)
+	data.SobjectType = types.StringValue(record.SobjectType)
+	data.DeveloperName = types.StringValue(record.DeveloperName)
+	data.NamespacePrefix = types.StringPointerValue(record.NamespacePrefix)
+	data.Name = types.StringValue(record.Name)
+	data.Description = types.StringPointerValue(record.Description)
+	data.IsActive = types.BoolValue(record.IsActive)
+	data.BusinessProcessId = types.StringPointerValue(record.BusinessProcessId)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceRecordType_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRecordType_basic(name, `["Red"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_record_type.test", "sobject_type", name+"__c"),
+					resource.TestCheckResourceAttr("data.salesforce_record_type.test", "name", "Standard"),
+					resource.TestCheckResourceAttr("data.salesforce_record_type.test", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("data.salesforce_record_type.test", "is_active", "true"),
+					resource.TestCheckNoResourceAttr("data.salesforce_record_type.test", "namespace_prefix"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceRecordType_notFound(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "salesforce_record_type" "test" {
+  sobject_type   = "Account"
+  developer_name = "tf_test_missing"
+}
+`,
+				ExpectError: regexp.MustCompile("No Record Type tf_test_missing on Account"),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type roleHierarchyDataSource struct {
+	client *force.ForceApi
+}
+
+var _ datasource.DataSource = &roleHierarchyDataSource{}
+
+func (d *roleHierarchyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_role_hierarchy"
+}
+
+func (d *roleHierarchyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Role Hierarchy Data Source for the Salesforce Provider. Returns the org's roles as a tree.",
+		Attributes: map[string]schema.Attribute{
+			"root_role_id": schema.StringAttribute{
+				Description: "Only return this role and the roles below it. Defaults to the whole hierarchy.",
+				Optional:    true,
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The roles in depth first order, each followed by the roles below it, with siblings ordered by developer_name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the role. Corresponds to Label on the user interface.",
+							Computed:    true,
+						},
+						"developer_name": schema.StringAttribute{
+							Description: "The unique name of the role in the API.",
+							Computed:    true,
+						},
+						"parent_role_id": schema.StringAttribute{
+							Description: "The ID of the parent role, null for roles at the top of the hierarchy.",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "Number of roles above this one, 0 for the top of the hierarchy or root_role_id.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "Developer names of the roles from the top of the hierarchy, or root_role_id, down to this one, separated by /.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type roleHierarchyDataModel struct {
+	RootRoleId types.String         `tfsdk:"root_role_id"`
+	Roles      []roleHierarchyModel `tfsdk:"roles"`
+}
+
+type roleHierarchyModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	DeveloperName types.String `tfsdk:"developer_name"`
+	ParentRoleId  types.String `tfsdk:"parent_role_id"`
+	Depth         types.Int64  `tfsdk:"depth"`
+	Path          types.String `tfsdk:"path"`
+}
+
+// buildRoleHierarchy orders the roles depth first below root, or below the top of the hierarchy when root is empty
+func buildRoleHierarchy(records []userRoleRecord, root string) ([]roleHierarchyModel, error) {
+	children := make(map[string][]userRoleRecord)
+	byId := make(map[string]userRoleRecord, len(records))
+	for _, record := range records {
+		byId[normalizeId(record.Id)] = record
+	}
+	for _, record := range records {
+		parent := ""
+		if record.ParentRoleId != nil {
+			parent = normalizeId(*record.ParentRoleId)
+		}
+		// roles whose parent isn't visible are treated as the top of the hierarchy
+		if _, ok := byId[parent]; !ok {
+			parent = ""
+		}
+		children[parent] = append(children[parent], record)
+	}
+	for _, siblings := range children {
+		sort.Slice(siblings, func(i, j int) bool {
+			return siblings[i].DeveloperName < siblings[j].DeveloperName
+		})
+	}
+
+	roles := []roleHierarchyModel{}
+	var walk func(record userRoleRecord, depth int64, parents []string)
+	walk = func(record userRoleRecord, depth int64, parents []string) {
+		names := append(append([]string{}, parents...), record.DeveloperName)
+		roles = append(roles, roleHierarchyModel{
+			Id:            types.StringValue(record.Id),
+			Name:          types.StringValue(record.Name),
+			DeveloperName: types.StringValue(record.DeveloperName),
+			ParentRoleId:  types.StringPointerValue(record.ParentRoleId),
+			Depth:         types.Int64Value(depth),
+			Path:          types.StringValue(strings.Join(names, "/")),
+		})
+		for _, child := range children[normalizeId(record.Id)] {
+			walk(child, depth+1, names)
+		}
+	}
+
+	if root != "" {
+		record, ok := byId[normalizeId(root)]
+		if !ok {
+			return nil, fmt.Errorf("no User Role with ID %s", root)
+		}
+		walk(record, 0, nil)
+		return roles, nil
+	}
+	for _, record := range children[""] {
+		walk(record, 0, nil)
+	}
+	return roles, nil
+}
+
+func (d *roleHierarchyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data roleHierarchyDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := force.BuildQuery("Id, Name, DeveloperName, ParentRoleId", "UserRole", nil)
+	records, err := queryAllRecords[userRoleRecord](d.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting User Roles", err.Error())
+		return
+	}
+
+	roles, err := buildRoleHierarchy(records, data.RootRoleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("root_role_id"), "Error Getting User Roles", err.Error())
+		return
+	}
+	data.Roles = roles
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
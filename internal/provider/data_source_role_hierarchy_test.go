@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBuildRoleHierarchy(t *testing.T) {
+	t.Parallel()
+
+	ceo := normalizeId("00E000000000001")
+	vp := normalizeId("00E000000000002")
+	records := []userRoleRecord{
+		{Id: normalizeId("00E000000000003"), Name: "Sales Rep", DeveloperName: "Sales_Rep", ParentRoleId: &vp},
+		{Id: vp, Name: "VP Sales", DeveloperName: "VP_Sales", ParentRoleId: &ceo},
+		{Id: normalizeId("00E000000000004"), Name: "CFO", DeveloperName: "CFO", ParentRoleId: &ceo},
+		{Id: ceo, Name: "CEO", DeveloperName: "CEO"},
+	}
+
+	roles, err := buildRoleHierarchy(records, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		path  string
+		depth int64
+	}{
+		{"CEO", 0},
+		{"CEO/CFO", 1},
+		{"CEO/VP_Sales", 1},
+		{"CEO/VP_Sales/Sales_Rep", 2},
+	}
+	if len(roles) != len(want) {
+		t.Fatalf("got %d roles, want %d", len(roles), len(want))
+	}
+	for i, w := range want {
+		if roles[i].Path.ValueString() != w.path || roles[i].Depth.ValueInt64() != w.depth {
+			t.Errorf("role %d = %s at depth %d, want %s at depth %d", i, roles[i].Path.ValueString(), roles[i].Depth.ValueInt64(), w.path, w.depth)
+		}
+	}
+
+	// 15 character IDs are accepted for the root
+	roles, err = buildRoleHierarchy(records, vp[:15])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roles) != 2 || roles[1].Path.ValueString() != "VP_Sales/Sales_Rep" || roles[1].Depth.ValueInt64() != 1 {
+		t.Errorf("unexpected subtree %v", roles)
+	}
+
+	if _, err := buildRoleHierarchy(records, "00E000000000009"); err == nil {
+		t.Error("expected an error for an unknown root")
+	}
+}
+
+func TestAccDataSourceRoleHierarchy_basic(t *testing.T) {
+	t.Parallel()
+	name := "roletest" + RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRoleHierarchy_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_role_hierarchy.test", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.salesforce_role_hierarchy.test", "roles.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.salesforce_role_hierarchy.test", "roles.1.path", fmt.Sprintf("%[1]s/%[1]s_child", name)),
+					resource.TestCheckResourceAttrPair("data.salesforce_user_role.child", "parent_role_id", "salesforce_user_role.parent", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRoleHierarchy_basic(name string) string {
+	return fmt.Sprintf(`
+resource "salesforce_user_role" "parent" {
+  name           = "%[1]s"
+  developer_name = "%[1]s"
+}
+
+resource "salesforce_user_role" "child" {
+  name           = "%[1]s child"
+  developer_name = "%[1]s_child"
+  parent_role_id = salesforce_user_role.parent.id
+}
+
+data "salesforce_user_role" "child" {
+  developer_name = salesforce_user_role.child.developer_name
+}
+
+data "salesforce_role_hierarchy" "test" {
+  root_role_id = salesforce_user_role.child.parent_role_id
+}
+`, name)
+}
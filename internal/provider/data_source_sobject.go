@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type sobjectDataSource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ datasource.DataSource                   = &sobjectDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &sobjectDataSource{}
+)
+
+func (d *sobjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_sobject"
+}
+
+func (d *sobjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generic SObject Data Source for the Salesforce Provider. Fetches a single record of any standard or custom object by ID or external ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the record. Exactly one of id or external_id must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"object_type": schema.StringAttribute{
+				Description: "API name of the object, for example Contact or Invoice__c.",
+				Required:    true,
+			},
+			"external_id_field": schema.StringAttribute{
+				Description: "API name of an external ID field to look the record up by. Required with external_id.",
+				Optional:    true,
+			},
+			"external_id": schema.StringAttribute{
+				Description: "Value of external_id_field to look the record up by. Exactly one of id or external_id must be set.",
+				Optional:    true,
+			},
+			"field_names": schema.ListAttribute{
+				Description: "API names of the fields to fetch. Defaults to every field on the object.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"fields": schema.DynamicAttribute{
+				Description: "Object of field API names to values of the record.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type sobjectDataModel struct {
+	Id              types.String  `tfsdk:"id"`
+	ObjectType      types.String  `tfsdk:"object_type"`
+	ExternalIdField types.String  `tfsdk:"external_id_field"`
+	ExternalId      types.String  `tfsdk:"external_id"`
+	FieldNames      types.List    `tfsdk:"field_names"`
+	Fields          types.Dynamic `tfsdk:"fields"`
+}
+
+func (d *sobjectDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data sobjectDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Id.IsNull() && !data.ExternalId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("external_id"), "Invalid Attribute Combination", "Only one of id or external_id may be set.")
+	}
+	if data.Id.IsNull() && data.ExternalId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Missing Attribute", "One of id or external_id must be set.")
+	}
+	if data.ExternalId.IsNull() != data.ExternalIdField.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("external_id_field"), "Invalid Attribute Combination", "external_id and external_id_field must be set together.")
+	}
+}
+
+func (d *sobjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sobjectDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectType := data.ObjectType.ValueString()
+	desc, err := describeSObject(d.client, objectType)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("object_type"), "Invalid object type", err.Error())
+		return
+	}
+	describeFields := sobjectFieldsByName(desc)
+
+	var fieldNames []string
+	if !data.FieldNames.IsNull() {
+		resp.Diagnostics.Append(data.FieldNames.ElementsAs(ctx, &fieldNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, name := range fieldNames {
+			if _, ok := describeFields[strings.ToLower(name)]; !ok {
+				resp.Diagnostics.AddAttributeError(path.Root("field_names"), "Invalid field", fmt.Sprintf("%s has no field named %s", desc.Name, name))
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	sobject := dynamicSObject{apiName: objectType}
+	var filter string
+	if !data.ExternalId.IsNull() {
+		field, ok := describeFields[strings.ToLower(data.ExternalIdField.ValueString())]
+		if !ok || !(field.ExternalId || field.IdLookup) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("external_id_field"),
+				"Invalid external ID field",
+				fmt.Sprintf("%s has no external ID field named %s", desc.Name, data.ExternalIdField.ValueString()),
+			)
+			return
+		}
+		sobject.externalIdApiName = field.Name
+		filter = fmt.Sprintf("%s = '%s'", field.Name, data.ExternalId.ValueString())
+		err = d.client.GetSObjectByExternalId(url.PathEscape(data.ExternalId.ValueString()), fieldNames, &sobject)
+	} else {
+		filter = fmt.Sprintf("Id = '%s'", data.Id.ValueString())
+		err = d.client.GetSObject(data.Id.ValueString(), fieldNames, &sobject)
+	}
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", objectType), fmt.Sprintf("No %s where %s", objectType, filter))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", objectType), err.Error())
+		return
+	}
+
+	if id, ok := lookupSObjectField(sobject.fields, "Id"); ok && data.Id.IsNull() {
+		if s, ok := id.(string); ok {
+			data.Id = types.StringValue(s)
+		}
+	}
+	fields, err := sobjectValueFromRaw(ctx, sobject.fields)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Reading %s", objectType), err.Error())
+		return
+	}
+	data.Fields = types.DynamicValue(fields)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
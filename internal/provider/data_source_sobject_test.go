@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSObject_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSObject_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.salesforce_sobject.test", "id", "salesforce_account.test", "id"),
+					resource.TestCheckResourceAttr("data.salesforce_sobject.test", "fields.Name", name),
+					resource.TestCheckResourceAttr("data.salesforce_sobject.test", "fields.Industry", "Technology"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSObject_basic(name string) string {
+	return fmt.Sprintf(`
+resource "salesforce_account" "test" {
+  name     = "%s"
+  industry = "Technology"
+}
+
+data "salesforce_sobject" "test" {
+  object_type = "Account"
+  id          = salesforce_account.test.id
+  field_names = ["Id", "Name", "Industry"]
+}
+`, name)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type soqlDataSource struct {
+	client *force.ForceApi
+}
+
+var _ datasource.DataSource = &soqlDataSource{}
+
+func (d *soqlDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_soql"
+}
+
+func (d *soqlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "SOQL Data Source for the Salesforce Provider. Runs a read-only SOQL query and returns every matching record, following pagination.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "SOQL SELECT statement. Values should be bound with :name placeholders rather than interpolated, for example `SELECT Id FROM Contact WHERE Email = :email`.",
+				Required:    true,
+				Validators: []validator.String{
+					readOnlySOQL{},
+				},
+			},
+			"parameters": schema.DynamicAttribute{
+				Description: "Object of values bound to the :name placeholders in the query. Strings are quoted and escaped, numbers and bools are rendered as is and lists are rendered for use with IN.",
+				Optional:    true,
+			},
+			"records": schema.DynamicAttribute{
+				Description: "List of records returned by the query. Each record is an object keyed by field API name, relationship fields are nested objects and subqueries are lists of records.",
+				Computed:    true,
+			},
+			"total_size": schema.Int64Attribute{
+				Description: "Number of records returned by the query.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type soqlDataModel struct {
+	Query      types.String  `tfsdk:"query"`
+	Parameters types.Dynamic `tfsdk:"parameters"`
+	Records    types.Dynamic `tfsdk:"records"`
+	TotalSize  types.Int64   `tfsdk:"total_size"`
+}
+
+// soqlParameterValue converts a bound parameter into a value soqlLiteral can render
+func soqlParameterValue(v attr.Value) (interface{}, error) {
+	var elements []attr.Value
+	switch v := v.(type) {
+	case types.Dynamic:
+		return soqlParameterValue(v.UnderlyingValue())
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	default:
+		return sobjectValueToInterface(v)
+	}
+	items := make([]interface{}, len(elements))
+	for i, element := range elements {
+		item, err := sobjectValueToInterface(element)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func (d *soqlDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data soqlDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elements, err := dynamicElements(ctx, data.Parameters)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid parameters", err.Error())
+		return
+	}
+	params := make(map[string]interface{}, len(elements))
+	for name, element := range elements {
+		v, err := soqlParameterValue(element)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid parameter", fmt.Sprintf("%s: %s", name, err))
+			return
+		}
+		params[name] = v
+	}
+	query, err := bindSOQLParameters(data.Query.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid SOQL query", err.Error())
+		return
+	}
+
+	records, err := queryAllRecords[map[string]interface{}](d.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Running SOQL Query", err.Error())
+		return
+	}
+
+	raw := make([]interface{}, len(records))
+	for i, record := range records {
+		raw[i] = record
+	}
+	value, err := sobjectValueFromRaw(ctx, raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading SOQL Records", err.Error())
+		return
+	}
+	data.Records = types.DynamicValue(value)
+	data.TotalSize = types.Int64Value(int64(len(records)))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSOQL_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSOQL_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_soql.test", "total_size", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_soql.test", "records.0.Name", name),
+					resource.TestCheckResourceAttrPair("data.salesforce_soql.test", "records.0.Id", "salesforce_account.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSOQL_basic(name string) string {
+	return fmt.Sprintf(`
+resource "salesforce_account" "test" {
+  name = "%s"
+}
+
+data "salesforce_soql" "test" {
+  query = "SELECT Id, Name FROM Account WHERE Name = :name"
+  parameters = {
+    name = salesforce_account.test.name
+  }
+}
+`, name)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type userDataSource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ datasource.DataSource                   = &userDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &userDataSource{}
+)
+
+// userLookupAttributes are the attributes a single user can be looked up by, with their User fields
+var userLookupAttributes = map[string]string{
+	"id":                    "Id",
+	"username":              "Username",
+	"federation_identifier": "FederationIdentifier",
+	"email":                 "Email",
+}
+
+func (d *userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_user"
+}
+
+func (d *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataAttributes()
+	for name := range userLookupAttributes {
+		attribute := attributes[name].(schema.StringAttribute)
+		attribute.Description += " Exactly one of id, username, federation_identifier or email must be set to look the user up by."
+		attribute.Optional = true
+		attributes[name] = attribute
+	}
+	resp.Schema = schema.Schema{
+		Description: "User Data Source for the Salesforce Provider. Fails unless exactly one user matches.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *userDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data userDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var set []string
+	for name, value := range userLookupValues(data) {
+		if value.IsUnknown() {
+			return
+		}
+		if !value.IsNull() {
+			set = append(set, name)
+		}
+	}
+	if len(set) != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of id, username, federation_identifier or email must be set.",
+		)
+	}
+}
+
+func userLookupValues(data userDataModel) map[string]types.String {
+	return map[string]types.String{
+		"id":                    data.Id,
+		"username":              data.Username,
+		"federation_identifier": data.FederationIdentifier,
+		"email":                 data.Email,
+	}
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter string
+	for name, value := range userLookupValues(data) {
+		if !value.IsNull() {
+			filter = fmt.Sprintf("%s = '%s'", userLookupAttributes[name], soqlEscape(value.ValueString()))
+		}
+	}
+	users, err := queryUsers(d.client, []string{filter})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting User", err.Error())
+		return
+	}
+	if len(users) == 0 {
+		resp.Diagnostics.AddError("Error Getting User", fmt.Sprintf("No User where %s", filter))
+		return
+	}
+	if len(users) > 1 {
+		usernames := make([]string, len(users))
+		for i, user := range users {
+			usernames[i] = user.Username.ValueString()
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting User",
+			fmt.Sprintf("%d Users where %s: %s. Look the user up by id or username instead.", len(users), filter, strings.Join(usernames, ", ")),
+		)
+		return
+	}
+
+	// keep the configured value, salesforce matches case insensitively and returns 18 character IDs
+	user := users[0]
+	switch {
+	case !data.Id.IsNull():
+		user.Id = data.Id
+	case !data.Username.IsNull():
+		user.Username = data.Username
+	case !data.FederationIdentifier.IsNull():
+		user.FederationIdentifier = data.FederationIdentifier
+	case !data.Email.IsNull():
+		user.Email = data.Email
+	}
+
+	diags = resp.State.Set(ctx, &user)
+	resp.Diagnostics.Append(diags...)
+}
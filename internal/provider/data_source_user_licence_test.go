@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -27,6 +28,21 @@ func TestAccDataSourceUserLicense_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceUserLicense_unknownKey(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceUserLicense_basic("SFCD"),
+				ExpectError: regexp.MustCompile(`Did you mean "SFDC"\?`),
+			},
+		},
+	})
+}
+
 func testAccDataSourceUserLicense_basic(name string) string {
 	return fmt.Sprintf(`
 data "salesforce_user_license" "test" {
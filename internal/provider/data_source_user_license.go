@@ -9,7 +9,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/picklists"
 	"github.com/nimajalali/go-force/force"
 	"github.com/nimajalali/go-force/sobjects"
 )
@@ -18,7 +20,10 @@ type userLicenseDataSource struct {
 	client *force.ForceApi
 }
 
-var _ datasource.DataSource = &userLicenseDataSource{}
+var (
+	_ datasource.DataSource                   = &userLicenseDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &userLicenseDataSource{}
+)
 
 func (d *userLicenseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = "salesforce_user_license"
@@ -36,6 +41,10 @@ func (d *userLicenseDataSource) Schema(ctx context.Context, req datasource.Schem
 				Description: "A string that uniquely identifies a particular user license. Valid options vary depending on organization type and configuration. For a complete list see https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_objects_userlicense.htm",
 				Required:    true,
 			},
+			"strict": schema.BoolAttribute{
+				Description: "Whether license_definition_key must be one of the keys known to the provider. Set to false to look up keys specific to your org, which are then only warned about. Defaults to true.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -43,6 +52,7 @@ func (d *userLicenseDataSource) Schema(ctx context.Context, req datasource.Schem
 type userLicenseDataModel struct {
 	Id                   types.String `tfsdk:"id"`
 	LicenseDefinitionKey types.String `tfsdk:"license_definition_key"`
+	Strict               types.Bool   `tfsdk:"strict"`
 }
 
 type userLicenseQueryResponse struct {
@@ -53,6 +63,34 @@ type userLicenseQueryResponse struct {
 	}
 }
 
+func (d *userLicenseDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data userLicenseDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.LicenseDefinitionKey.IsUnknown() || data.Strict.IsUnknown() {
+		return
+	}
+	key := data.LicenseDefinitionKey.ValueString()
+	for _, known := range picklists.LicenseDefinitionKeys {
+		if key == known {
+			return
+		}
+	}
+	detail := fmt.Sprintf("%q is not a known license definition key.%s", key, didYouMean(key, picklists.LicenseDefinitionKeys))
+	if data.Strict.IsNull() || data.Strict.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("license_definition_key"),
+			"Unknown license definition key",
+			detail+" Set strict to false if the key is specific to your org.",
+		)
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("license_definition_key"), "Unknown license definition key", detail)
+}
+
 func (d *userLicenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data userLicenseDataModel
 	diags := req.Config.Get(ctx, &data)
@@ -62,7 +100,7 @@ func (d *userLicenseDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	var query userLicenseQueryResponse
-	licenseDefinitionKeyFilter := fmt.Sprintf("LicenseDefinitionKey = '%s'", data.LicenseDefinitionKey.ValueString())
+	licenseDefinitionKeyFilter := fmt.Sprintf("LicenseDefinitionKey = '%s'", soqlEscape(data.LicenseDefinitionKey.ValueString()))
 	if err := d.client.Query(force.BuildQuery("Id, LicenseDefinitionKey", "UserLicense", []string{licenseDefinitionKeyFilter}), &query); err != nil {
 		resp.Diagnostics.AddError("Error Getting User License", err.Error())
 		return
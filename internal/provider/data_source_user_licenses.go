@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type userLicensesDataSource struct {
+	client *force.ForceApi
+}
+
+var _ datasource.DataSource = &userLicensesDataSource{}
+
+func (d *userLicensesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_user_licenses"
+}
+
+func (d *userLicensesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "User Licenses Data Source for the Salesforce Provider. Lists every user license in the org with its seat usage, for example to check available_licenses in a precondition before creating users.",
+		Attributes: map[string]schema.Attribute{
+			"licenses": schema.ListNestedAttribute{
+				Description: "The user licenses of the org, ordered by license_definition_key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the user license.",
+							Computed:    true,
+						},
+						"license_definition_key": schema.StringAttribute{
+							Description: "A string that uniquely identifies the user license.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the user license, for example Salesforce or Chatter Free.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the user license, for example Active or Disabled.",
+							Computed:    true,
+						},
+						"total_licenses": schema.Int64Attribute{
+							Description: "Number of seats purchased for the license.",
+							Computed:    true,
+						},
+						"used_licenses": schema.Int64Attribute{
+							Description: "Number of seats assigned to users.",
+							Computed:    true,
+						},
+						"available_licenses": schema.Int64Attribute{
+							Description: "Number of seats left to assign, total_licenses minus used_licenses.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type userLicensesDataModel struct {
+	Licenses []userLicenseModel `tfsdk:"licenses"`
+}
+
+type userLicenseModel struct {
+	Id                   types.String `tfsdk:"id"`
+	LicenseDefinitionKey types.String `tfsdk:"license_definition_key"`
+	Name                 types.String `tfsdk:"name"`
+	Status               types.String `tfsdk:"status"`
+	TotalLicenses        types.Int64  `tfsdk:"total_licenses"`
+	UsedLicenses         types.Int64  `tfsdk:"used_licenses"`
+	AvailableLicenses    types.Int64  `tfsdk:"available_licenses"`
+}
+
+func (d *userLicensesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userLicensesDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := force.BuildQuery("Id, LicenseDefinitionKey, Name, Status, TotalLicenses, UsedLicenses", "UserLicense", nil) + " ORDER BY LicenseDefinitionKey"
+	records, err := queryAllRecords[struct {
+		Id                   string `json:"Id"`
+		LicenseDefinitionKey string `json:"LicenseDefinitionKey"`
+		Name                 string `json:"Name"`
+		Status               string `json:"Status"`
+		TotalLicenses        int64  `json:"TotalLicenses"`
+		UsedLicenses         int64  `json:"UsedLicenses"`
+	}](d.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting User Licenses", err.Error())
+		return
+	}
+
+	data.Licenses = make([]userLicenseModel, len(records))
+	for i, record := range records {
+		data.Licenses[i] = userLicenseModel{
+			Id:                   types.StringValue(record.Id),
+			LicenseDefinitionKey: types.StringValue(record.LicenseDefinitionKey),
+			Name:                 types.StringValue(record.Name),
+			Status:               types.StringValue(record.Status),
+			TotalLicenses:        types.Int64Value(record.TotalLicenses),
+			UsedLicenses:         types.Int64Value(record.UsedLicenses),
+			AvailableLicenses:    types.Int64Value(record.TotalLicenses - record.UsedLicenses),
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
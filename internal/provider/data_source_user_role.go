@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type userRoleDataSource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ datasource.DataSource                   = &userRoleDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &userRoleDataSource{}
+)
+
+func (d *userRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_user_role"
+}
+
+func (d *userRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "User Role Data Source for the Salesforce Provider",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the role. Corresponds to Label on the user interface. Exactly one of name or developer_name must be set, and the name must match a single role.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"developer_name": schema.StringAttribute{
+				Description: "The unique name of the role in the API. Exactly one of name or developer_name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"parent_role_id": schema.StringAttribute{
+				Description: "The ID of the parent role.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type userRoleDataModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	DeveloperName types.String `tfsdk:"developer_name"`
+	ParentRoleId  types.String `tfsdk:"parent_role_id"`
+}
+
+// userRoleRecord is a UserRole returned by a query
+type userRoleRecord struct {
+	Id            string  `json:"Id"`
+	Name          string  `json:"Name"`
+	DeveloperName string  `json:"DeveloperName"`
+	ParentRoleId  *string `json:"ParentRoleId"`
+}
+
+func (d *userRoleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data userRoleDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsUnknown() || data.DeveloperName.IsUnknown() {
+		return
+	}
+	if data.Name.IsNull() == data.DeveloperName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("developer_name"), "Invalid Attribute Combination", "Exactly one of name or developer_name must be set.")
+	}
+}
+
+func (d *userRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userRoleDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter string
+	if !data.DeveloperName.IsNull() {
+		filter = fmt.Sprintf("DeveloperName = '%s'", soqlEscape(data.DeveloperName.ValueString()))
+	} else {
+		filter = fmt.Sprintf("Name = '%s'", soqlEscape(data.Name.ValueString()))
+	}
+	query := force.BuildQuery("Id, Name, DeveloperName, ParentRoleId", "UserRole", []string{filter})
+	records, err := queryAllRecords[userRoleRecord](d.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting User Role", err.Error())
+		return
+	}
+	if len(records) == 0 {
+		resp.Diagnostics.AddError("Error Getting User Role", fmt.Sprintf("No User Role where %s", filter))
+		return
+	}
+	if len(records) > 1 {
+		resp.Diagnostics.AddError("Error Getting User Role", fmt.Sprintf("%d User Roles where %s, look the role up by developer_name instead", len(records), filter))
+		return
+	}
+
+	record := records[0]
+	data.Id = types.StringValue(record.Id)
+	data.Name = types.StringValue(record.Name)
+	data.DeveloperName = types.StringValue(record.DeveloperName)
+	data.ParentRoleId = types.StringPointerValue(record.ParentRoleId)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUser_basic(t *testing.T) {
+	t.Parallel()
+
+	username := os.Getenv("SALESFORCE_USERNAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUser_basic(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_user.test", "username", username),
+					resource.TestCheckResourceAttr("data.salesforce_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("data.salesforce_user.test", "id"),
+					resource.TestCheckResourceAttrPair("data.salesforce_user.by_id", "username", "data.salesforce_user.test", "username"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceUser_noLookup(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "salesforce_user" "test" {
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of id, username, federation_identifier or email must be set"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUser_basic(username string) string {
+	return fmt.Sprintf(`
+data "salesforce_user" "test" {
+  username = "%s"
+}
+
+data "salesforce_user" "by_id" {
+  id = data.salesforce_user.test.id
+}
+`, username)
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type usersDataSource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ datasource.DataSource                   = &usersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &usersDataSource{}
+)
+
+func (d *usersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "salesforce_users"
+}
+
+func (d *usersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Users Data Source for the Salesforce Provider. Lists the users matching every filter that is set, all users if none are.",
+		Attributes: map[string]schema.Attribute{
+			"profile_id": schema.StringAttribute{
+				Description: "Only return users with this Profile.",
+				Optional:    true,
+			},
+			"user_role_id": schema.StringAttribute{
+				Description: "Only return users with this UserRole.",
+				Optional:    true,
+			},
+			"include_subordinate_roles": schema.BoolAttribute{
+				Description: "Also return the users of every role below user_role_id in the role hierarchy. Defaults to false.",
+				Optional:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Only return active users when true, or deactivated users when false.",
+				Optional:    true,
+			},
+			"username_prefix": schema.StringAttribute{
+				Description: "Only return users whose username starts with this value.",
+				Optional:    true,
+			},
+			"email_domain": schema.StringAttribute{
+				Description: "Only return users whose email address is in this domain, for example example.com.",
+				Optional:    true,
+			},
+			"federation_identifier": schema.StringAttribute{
+				Description: "Only return users with this federation ID.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The matching users, ordered by username.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// userDataAttributes are the attributes of a user returned by a data source, mirroring the resource
+func userDataAttributes() map[string]schema.Attribute {
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{Description: description, Computed: true}
+	}
+	computedBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{Description: description, Computed: true}
+	}
+	return map[string]schema.Attribute{
+		"id":                    computedString("ID of the user."),
+		"alias":                 computedString("The user's alias."),
+		"email":                 computedString("The user's email address."),
+		"email_encoding_key":    computedString("The email encoding for the user."),
+		"language_locale_key":   computedString("The user's language."),
+		"last_name":             computedString("The user's last name."),
+		"locale_sid_key":        computedString("The user's locale."),
+		"profile_id":            computedString("ID of the user's Profile."),
+		"time_zone_sid_key":     computedString("The user's time zone."),
+		"username":              computedString("The name the user logs in with."),
+		"user_role_id":          computedString("ID of the user's UserRole."),
+		"first_name":            computedString("The user's first name."),
+		"title":                 computedString("The user's business title."),
+		"department":            computedString("The company department associated with the user."),
+		"company_name":          computedString("The name of the user's company."),
+		"manager_id":            computedString("ID of the user who manages this user."),
+		"federation_identifier": computedString("The value used to identify the user for SAML single sign-on."),
+		"phone":                 computedString("The user's phone number."),
+		"mobile_phone":          computedString("The user's mobile phone number."),
+		"employee_number":       computedString("The user's employee number."),
+		"community_nickname":    computedString("Name used to identify the user in Experience Cloud sites and Chatter."),
+		"address": schema.SingleNestedAttribute{
+			Description: "The user's address.",
+			Computed:    true,
+			Attributes:  addressDataAttributes("user's"),
+		},
+		"is_active":                         computedBool("Whether the user is active."),
+		"is_frozen":                         computedBool("Whether the user is frozen."),
+		"user_permissions_marketing_user":   computedBool("Whether the user has the Marketing User feature license."),
+		"user_permissions_knowledge_user":   computedBool("Whether the user has the Knowledge User feature license."),
+		"user_permissions_offline_user":     computedBool("Whether the user has the Offline User feature license."),
+		"user_permissions_sf_content_user":  computedBool("Whether the user has the Salesforce CRM Content User feature license."),
+		"user_permissions_support_user":     computedBool("Whether the user has the Service Cloud User feature license."),
+		"user_permissions_interaction_user": computedBool("Whether the user has the Flow User feature license."),
+	}
+}
+
+type usersDataModel struct {
+	ProfileId               types.String    `tfsdk:"profile_id"`
+	UserRoleId              types.String    `tfsdk:"user_role_id"`
+	IncludeSubordinateRoles types.Bool      `tfsdk:"include_subordinate_roles"`
+	IsActive                types.Bool      `tfsdk:"is_active"`
+	UsernamePrefix          types.String    `tfsdk:"username_prefix"`
+	EmailDomain             types.String    `tfsdk:"email_domain"`
+	FederationIdentifier    types.String    `tfsdk:"federation_identifier"`
+	Users                   []userDataModel `tfsdk:"users"`
+}
+
+type userDataModel struct {
+	Id                             types.String  `tfsdk:"id"`
+	Alias                          types.String  `tfsdk:"alias"`
+	Email                          types.String  `tfsdk:"email"`
+	EmailEncodingKey               types.String  `tfsdk:"email_encoding_key"`
+	LanguageLocaleKey              types.String  `tfsdk:"language_locale_key"`
+	LastName                       types.String  `tfsdk:"last_name"`
+	LocaleSidKey                   types.String  `tfsdk:"locale_sid_key"`
+	ProfileID                      types.String  `tfsdk:"profile_id"`
+	TimeZoneSidKey                 types.String  `tfsdk:"time_zone_sid_key"`
+	Username                       types.String  `tfsdk:"username"`
+	UserRoleId                     types.String  `tfsdk:"user_role_id"`
+	FirstName                      types.String  `tfsdk:"first_name"`
+	Title                          types.String  `tfsdk:"title"`
+	Department                     types.String  `tfsdk:"department"`
+	CompanyName                    types.String  `tfsdk:"company_name"`
+	ManagerId                      types.String  `tfsdk:"manager_id"`
+	FederationIdentifier           types.String  `tfsdk:"federation_identifier"`
+	Phone                          types.String  `tfsdk:"phone"`
+	MobilePhone                    types.String  `tfsdk:"mobile_phone"`
+	EmployeeNumber                 types.String  `tfsdk:"employee_number"`
+	CommunityNickname              types.String  `tfsdk:"community_nickname"`
+	Address                        *addressModel `tfsdk:"address"`
+	IsActive                       types.Bool    `tfsdk:"is_active"`
+	IsFrozen                       types.Bool    `tfsdk:"is_frozen"`
+	UserPermissionsMarketingUser   types.Bool    `tfsdk:"user_permissions_marketing_user"`
+	UserPermissionsKnowledgeUser   types.Bool    `tfsdk:"user_permissions_knowledge_user"`
+	UserPermissionsOfflineUser     types.Bool    `tfsdk:"user_permissions_offline_user"`
+	UserPermissionsSFContentUser   types.Bool    `tfsdk:"user_permissions_sf_content_user"`
+	UserPermissionsSupportUser     types.Bool    `tfsdk:"user_permissions_support_user"`
+	UserPermissionsInteractionUser types.Bool    `tfsdk:"user_permissions_interaction_user"`
+}
+
+// userRecord is a User returned by a query, with the read only fields alongside the writable ones
+type userRecord struct {
+	Id       string `json:"Id"`
+	IsActive bool   `json:"IsActive"`
+	customUser
+}
+
+// userSelectFields lists the User fields to query, leaving out feature licenses the org doesn't have
+func userSelectFields(desc *force.SObjectDescription) []string {
+	fields := []string{
+		"Id", "IsActive", "Alias", "Email", "EmailEncodingKey", "LanguageLocaleKey", "LastName", "LocaleSidKey",
+		"ProfileId", "TimeZoneSidKey", "Username", "UserRoleId", "FirstName", "Title", "Department", "CompanyName",
+		"ManagerId", "FederationIdentifier", "Phone", "MobilePhone", "EmployeeNumber", "CommunityNickname",
+		"Street", "City", "State", "PostalCode", "Country",
+	}
+	describeFields := sobjectFieldsByName(desc)
+	for _, name := range []string{
+		"UserPermissionsMarketingUser", "UserPermissionsKnowledgeUser", "UserPermissionsOfflineUser",
+		"UserPermissionsSFContentUser", "UserPermissionsSupportUser", "UserPermissionsInteractionUser",
+	} {
+		if _, ok := describeFields[strings.ToLower(name)]; ok {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// queryUsers returns the users matching the SOQL conditions along with their frozen state
+func queryUsers(client *force.ForceApi, where []string) ([]userDataModel, error) {
+	desc, err := describeSObject(client, "User")
+	if err != nil {
+		return nil, err
+	}
+	query := force.BuildQuery(strings.Join(userSelectFields(desc), ", "), "User", where) + " ORDER BY Username"
+	records, err := queryAllRecords[userRecord](client, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return []userDataModel{}, nil
+	}
+
+	// a semi-join keeps this to one query however many users match
+	loginQuery := "SELECT UserId, IsFrozen FROM UserLogin WHERE UserId IN (" + force.BuildQuery("Id", "User", where) + ")"
+	logins, err := queryAllRecords[struct {
+		UserId   string `json:"UserId"`
+		IsFrozen bool   `json:"IsFrozen"`
+	}](client, loginQuery)
+	if err != nil {
+		return nil, err
+	}
+	frozen := make(map[string]bool, len(logins))
+	for _, login := range logins {
+		frozen[login.UserId] = login.IsFrozen
+	}
+
+	users := make([]userDataModel, len(records))
+	for i, record := range records {
+		users[i] = flattenUserData(record, frozen[record.Id])
+	}
+	return users, nil
+}
+
+func flattenUserData(record userRecord, isFrozen bool) userDataModel {
+	var resource userResourceModel
+	flattenUser(&resource, record.customUser)
+	address := resource.Address
+	if address == nil {
+		address = &addressModel{
+			Street:     types.StringNull(),
+			City:       types.StringNull(),
+			State:      types.StringNull(),
+			PostalCode: types.StringNull(),
+			Country:    types.StringNull(),
+		}
+	}
+	return userDataModel{
+		Id:                             types.StringValue(record.Id),
+		Alias:                          resource.Alias,
+		Email:                          resource.Email,
+		EmailEncodingKey:               resource.EmailEncodingKey,
+		LanguageLocaleKey:              resource.LanguageLocaleKey,
+		LastName:                       resource.LastName,
+		LocaleSidKey:                   resource.LocaleSidKey,
+		ProfileID:                      resource.ProfileID,
+		TimeZoneSidKey:                 resource.TimeZoneSidKey,
+		Username:                       resource.Username,
+		UserRoleId:                     resource.UserRoleId,
+		FirstName:                      resource.FirstName,
+		Title:                          resource.Title,
+		Department:                     resource.Department,
+		CompanyName:                    resource.CompanyName,
+		ManagerId:                      resource.ManagerId,
+		FederationIdentifier:           resource.FederationIdentifier,
+		Phone:                          resource.Phone,
+		MobilePhone:                    resource.MobilePhone,
+		EmployeeNumber:                 resource.EmployeeNumber,
+		CommunityNickname:              resource.CommunityNickname,
+		Address:                        address,
+		IsActive:                       types.BoolValue(record.IsActive),
+		IsFrozen:                       types.BoolValue(isFrozen),
+		UserPermissionsMarketingUser:   resource.UserPermissionsMarketingUser,
+		UserPermissionsKnowledgeUser:   resource.UserPermissionsKnowledgeUser,
+		UserPermissionsOfflineUser:     resource.UserPermissionsOfflineUser,
+		UserPermissionsSFContentUser:   resource.UserPermissionsSFContentUser,
+		UserPermissionsSupportUser:     resource.UserPermissionsSupportUser,
+		UserPermissionsInteractionUser: resource.UserPermissionsInteractionUser,
+	}
+}
+
+// subordinateRoleIds returns the ID of the role and of every role below it in the hierarchy
+func subordinateRoleIds(client *force.ForceApi, roleId string) ([]string, error) {
+	query := force.BuildQuery("Id, Name, DeveloperName, ParentRoleId", "UserRole", nil)
+	records, err := queryAllRecords[userRoleRecord](client, query)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := buildRoleHierarchy(records, roleId)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(roles))
+	for i, role := range roles {
+		ids[i] = role.Id.ValueString()
+	}
+	return ids, nil
+}
+
+func (d *usersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data usersDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IncludeSubordinateRoles.ValueBool() && data.UserRoleId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("include_subordinate_roles"), "Missing user_role_id", "include_subordinate_roles requires user_role_id to be set.")
+	}
+	if domain := data.EmailDomain.ValueString(); strings.Contains(domain, "@") {
+		resp.Diagnostics.AddAttributeError(path.Root("email_domain"), "Invalid email domain", "email_domain must not include the @, for example example.com.")
+	}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data usersDataModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var where []string
+	if !data.ProfileId.IsNull() {
+		where = append(where, fmt.Sprintf("ProfileId = '%s'", soqlEscape(data.ProfileId.ValueString())))
+	}
+	if data.IncludeSubordinateRoles.ValueBool() && !data.UserRoleId.IsNull() {
+		roleIds, err := subordinateRoleIds(d.client, data.UserRoleId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("user_role_id"), "Error Getting User Roles", err.Error())
+			return
+		}
+		roles, _ := soqlLiteral(roleIds)
+		where = append(where, "UserRoleId IN "+roles)
+	} else if !data.UserRoleId.IsNull() {
+		where = append(where, fmt.Sprintf("UserRoleId = '%s'", soqlEscape(data.UserRoleId.ValueString())))
+	}
+	if !data.IsActive.IsNull() {
+		where = append(where, fmt.Sprintf("IsActive = %t", data.IsActive.ValueBool()))
+	}
+	if !data.UsernamePrefix.IsNull() {
+		where = append(where, fmt.Sprintf("Username LIKE '%s%%'", soqlLikeEscape(data.UsernamePrefix.ValueString())))
+	}
+	if !data.EmailDomain.IsNull() {
+		where = append(where, fmt.Sprintf("Email LIKE '%%@%s'", soqlLikeEscape(data.EmailDomain.ValueString())))
+	}
+	if !data.FederationIdentifier.IsNull() {
+		where = append(where, fmt.Sprintf("FederationIdentifier = '%s'", soqlEscape(data.FederationIdentifier.ValueString())))
+	}
+
+	users, err := queryUsers(d.client, where)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Users", err.Error())
+		return
+	}
+	data.Users = users
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUsers_basic(t *testing.T) {
+	t.Parallel()
+
+	username := os.Getenv("SALESFORCE_USERNAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsers_basic(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_users.test", "users.0.username", username),
+					resource.TestCheckResourceAttr("data.salesforce_users.test", "users.0.is_active", "true"),
+					resource.TestCheckResourceAttrSet("data.salesforce_users.test", "users.0.profile_id"),
+				),
+			},
+			{
+				Config: testAccDataSourceUsers_profile(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.salesforce_users.profile", "users.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceUsers_basic(username string) string {
+	return fmt.Sprintf(`
+data "salesforce_users" "test" {
+  username_prefix = "%s"
+  is_active       = true
+}
+`, username)
+}
+
+func testAccDataSourceUsers_profile(username string) string {
+	return testAccDataSourceUsers_basic(username) + `
+data "salesforce_users" "profile" {
+  profile_id = data.salesforce_users.test.users[0].profile_id
+}
+`
+}
@@ -35,7 +35,9 @@ func normalizeId(id string) string {
 	return id + addon
 }
 
-type NormalizeId struct{}
+type NormalizeId struct {
+	emptyDescriptions
+}
 
 func (NormalizeId) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
 	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
@@ -50,7 +52,17 @@ func (NormalizeId) PlanModifyString(ctx context.Context, req planmodifier.String
 	}
 }
 
+// sameId returns the prior value of an ID attribute when it's the same ID as the one read from the API,
+// so IDs configured in the 15 character format are kept as configured instead of producing diffs
+func sameId(prior types.String, remote *string) types.String {
+	if remote != nil && !prior.IsNull() && !prior.IsUnknown() && normalizeId(prior.ValueString()) == normalizeId(*remote) {
+		return prior
+	}
+	return types.StringPointerValue(remote)
+}
+
 type resourceDefaults struct {
+	emptyDescriptions
 	defaults map[string]attr.Value
 }
 
@@ -86,7 +98,9 @@ func (fixNullToUnknown) PlanModifyString(ctx context.Context, req planmodifier.S
 	}
 }
 
-type booleanNilIsFalse struct{}
+type booleanNilIsFalse struct {
+	emptyDescriptions
+}
 
 func (booleanNilIsFalse) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
 	resp.PlanValue = req.PlanValue
@@ -94,3 +108,14 @@ func (booleanNilIsFalse) PlanModifyBool(ctx context.Context, req planmodifier.Bo
 		resp.PlanValue = types.BoolValue(false)
 	}
 }
+
+type booleanNilIsTrue struct {
+	emptyDescriptions
+}
+
+func (booleanNilIsTrue) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	resp.PlanValue = req.PlanValue
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.BoolValue(true)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/picklists"
+	"github.com/nimajalali/go-force/force"
+)
+
+// userPicklistFallbacks are the static lists used for the User picklists when the org can't be described,
+// such as during terraform validate before the provider is configured
+var userPicklistFallbacks = map[string][]string{
+	"TimeZoneSidKey":    picklists.TimeZoneSidKeys,
+	"LocaleSidKey":      picklists.LocaleSidKeys,
+	"LanguageLocaleKey": picklists.LanguageLocaleKeys,
+	"EmailEncodingKey":  picklists.EmailEncodingKeys,
+}
+
+// picklistCache holds the User picklist values of the org, described once per provider run.
+// It's created with the provider and shared with schemas, which the framework caches before configure.
+type picklistCache struct {
+	mu     sync.Mutex
+	client *force.ForceApi
+	values map[string][]string
+}
+
+func (c *picklistCache) setClient(client *force.ForceApi) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.client = client
+	c.values = nil
+}
+
+// userPicklist returns the active values of a User picklist field and whether they were described
+// from the org, falling back to the static list
+func (c *picklistCache) userPicklist(field string) ([]string, bool) {
+	if c == nil {
+		return userPicklistFallbacks[field], false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == nil {
+		return userPicklistFallbacks[field], false
+	}
+	if c.values == nil {
+		// a failed describe is cached as empty too, so validation doesn't retry it for every attribute
+		c.values = make(map[string][]string)
+		if desc, err := describeSObject(c.client, "User"); err == nil {
+			for _, f := range desc.Fields {
+				if _, ok := userPicklistFallbacks[f.Name]; !ok {
+					continue
+				}
+				for _, pv := range f.PicklistValues {
+					if pv.Active {
+						c.values[f.Name] = append(c.values[f.Name], pv.Value)
+					}
+				}
+			}
+		}
+	}
+	if values := c.values[field]; len(values) > 0 {
+		return values, true
+	}
+	return userPicklistFallbacks[field], false
+}
+
+// userPicklist validates a string against a User picklist from the cache
+type userPicklist struct {
+	cache *picklistCache
+	field string
+}
+
+func (v userPicklist) Description(ctx context.Context) string {
+	return fmt.Sprintf("Ensures the string is a valid User %s.", v.field)
+}
+
+func (v userPicklist) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v userPicklist) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	values, described := v.cache.userPicklist(v.field)
+	value := req.ConfigValue.ValueString()
+	for _, item := range values {
+		if value == item {
+			return
+		}
+	}
+	// the lists run to hundreds of values, only name a few when there is no close match
+	detail := didYouMean(value, values)
+	if detail == "" && len(values) > 0 {
+		examples := values
+		if len(examples) > 5 {
+			examples = examples[:5]
+		}
+		detail = fmt.Sprintf(" Valid values include: %s.", strings.Join(examples, ", "))
+	}
+	if !described {
+		// the static lists can be missing values the org has, leave it to Salesforce to reject the value
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Unknown value",
+			fmt.Sprintf("%q isn't in the built-in list of %s values, it's checked against the org when applied.%s", value, v.field, detail),
+		)
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid string",
+		fmt.Sprintf("%q is not a valid %s.%s", value, v.field, detail),
+	)
+}
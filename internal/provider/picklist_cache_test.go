@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+func TestUserPicklistFallback(t *testing.T) {
+	t.Parallel()
+
+	// without a client the static lists are used, which can be missing values of the org, so they only warn
+	v := userPicklist{cache: &picklistCache{}, field: "TimeZoneSidKey"}
+
+	cases := []struct {
+		value    types.String
+		wantWarn string
+	}{
+		{types.StringValue("America/New_York"), ""},
+		{types.StringNull(), ""},
+		{types.StringUnknown(), ""},
+		{types.StringValue("America/New_Yrok"), `Did you mean "America/New_York"?`},
+		{types.StringValue("Nowhere"), "Valid values include:"},
+	}
+	for _, c := range cases {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("time_zone_sid_key"), ConfigValue: c.value}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error %v", c.value, resp.Diagnostics)
+		}
+		if c.wantWarn == "" {
+			if resp.Diagnostics.WarningsCount() > 0 {
+				t.Errorf("%s: unexpected warning %v", c.value, resp.Diagnostics)
+			}
+			continue
+		}
+		if resp.Diagnostics.WarningsCount() == 0 || !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), c.wantWarn) {
+			t.Errorf("%s: expected warning containing %q, got %v", c.value, c.wantWarn, resp.Diagnostics)
+		}
+	}
+}
+
+func TestUserPicklistDescribed(t *testing.T) {
+	t.Parallel()
+
+	// values described from the org are authoritative
+	cache := &picklistCache{client: &force.ForceApi{}, values: map[string][]string{"TimeZoneSidKey": {"America/New_York", "Australia/Melbourne"}}}
+	v := userPicklist{cache: cache, field: "TimeZoneSidKey"}
+
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("time_zone_sid_key"), ConfigValue: types.StringValue("Australia/Melbourne")}, resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Errorf("unexpected diagnostics %v", resp.Diagnostics)
+	}
+
+	resp = &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("time_zone_sid_key"), ConfigValue: types.StringValue("Australia/Melborne")}, resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), `Did you mean "Australia/Melbourne"?`) {
+		t.Errorf("expected an error suggesting Australia/Melbourne, got %v", resp.Diagnostics)
+	}
+}
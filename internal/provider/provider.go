@@ -13,18 +13,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-salesforce/internal/auth"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
 	"github.com/nimajalali/go-force/force"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 type salesforceProvider struct {
-	client *force.ForceApi
+	client    *force.ForceApi
+	metadata  *metadata.Client
+	picklists *picklistCache
 }
 
 var _ provider.Provider = &salesforceProvider{}
 
 func New() provider.Provider {
-	return &salesforceProvider{}
+	return &salesforceProvider{picklists: &picklistCache{}}
 }
 
 func (p *salesforceProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -139,6 +142,8 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 	p.client = client
+	p.metadata = metadata.New(client.GetInstanceURL(), client.GetAccessToken(), config.ApiVersion.ValueString())
+	p.picklists.setClient(client)
 }
 
 func (p *salesforceProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -146,6 +151,14 @@ func (p *salesforceProvider) DataSources(ctx context.Context) []func() datasourc
 		func() datasource.DataSource { return &profileDataSource{client: p.client} },
 		func() datasource.DataSource { return &accountDataSource{client: p.client} },
 		func() datasource.DataSource { return &userLicenseDataSource{client: p.client} },
+		func() datasource.DataSource { return &userLicensesDataSource{client: p.client} },
+		func() datasource.DataSource { return &soqlDataSource{client: p.client} },
+		func() datasource.DataSource { return &sobjectDataSource{client: p.client} },
+		func() datasource.DataSource { return &usersDataSource{client: p.client} },
+		func() datasource.DataSource { return &userDataSource{client: p.client} },
+		func() datasource.DataSource { return &userRoleDataSource{client: p.client} },
+		func() datasource.DataSource { return &roleHierarchyDataSource{client: p.client} },
+		func() datasource.DataSource { return &recordTypeDataSource{client: p.client} },
 	}
 }
 
@@ -153,8 +166,22 @@ func (p *salesforceProvider) Resources(ctx context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		func() resource.Resource { return &accountResource{client: p.client} },
 		func() resource.Resource { return &profileResource{client: p.client} },
-		func() resource.Resource { return &userResource{client: p.client} },
+		func() resource.Resource { return &userResource{client: p.client, picklists: p.picklists} },
 		func() resource.Resource { return &userRoleResource{client: p.client} },
+		func() resource.Resource { return &sobjectResource{client: p.client} },
+		func() resource.Resource { return &customObjectResource{metadata: p.metadata} },
+		func() resource.Resource { return &customFieldResource{metadata: p.metadata} },
+		func() resource.Resource { return &validationRuleResource{metadata: p.metadata} },
+		func() resource.Resource { return &globalValueSetResource{client: p.client, metadata: p.metadata} },
+		func() resource.Resource { return &standardValueSetResource{client: p.client, metadata: p.metadata} },
+		func() resource.Resource { return &recordTypeResource{client: p.client, metadata: p.metadata} },
+		func() resource.Resource { return &customMetadataRecordResource{client: p.client, metadata: p.metadata} },
+		func() resource.Resource { return &customSettingValueResource{client: p.client} },
+		func() resource.Resource { return &remoteSiteSettingResource{metadata: p.metadata} },
+		func() resource.Resource { return &cspTrustedSiteResource{metadata: p.metadata} },
+		func() resource.Resource { return &externalCredentialResource{client: p.client, metadata: p.metadata} },
+		func() resource.Resource { return &namedCredentialResource{metadata: p.metadata} },
+		func() resource.Resource { return &permissionSetPrincipalAccessResource{client: p.client} },
 	}
 }
 
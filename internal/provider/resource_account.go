@@ -5,18 +5,33 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/nimajalali/go-force/force"
+	"github.com/nimajalali/go-force/forcejson"
 )
 
 type accountResource struct {
 	client *force.ForceApi
 }
 
-var _ resource.Resource = &accountResource{}
+var (
+	_ resource.Resource                   = &accountResource{}
+	_ resource.ResourceWithImportState    = &accountResource{}
+	_ resource.ResourceWithValidateConfig = &accountResource{}
+	_ resource.ResourceWithModifyPlan     = &accountResource{}
+)
 
 func (r *accountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "salesforce_account"
@@ -54,28 +69,135 @@ func (r *accountResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "Website URL.",
 				Optional:    true,
 			},
+			"description": schema.StringAttribute{
+				Description: "Text description of the account.",
+				Optional:    true,
+			},
+			"annual_revenue": schema.Float64Attribute{
+				Description: "Estimated annual revenue of the account.",
+				Optional:    true,
+			},
+			"number_of_employees": schema.Int64Attribute{
+				Description: "Number of employees working at the account.",
+				Optional:    true,
+			},
+			"rating": schema.StringAttribute{
+				Description: "The account's prospect rating (e.g., Hot, Warm, Cold). Validated against the org's picklist values.",
+				Optional:    true,
+			},
+			"ownership": schema.StringAttribute{
+				Description: "Ownership type for the account (e.g., Public, Private, Subsidiary, Other). Validated against the org's picklist values.",
+				Optional:    true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "ID of the user who owns the account. Defaults to the user the provider authenticates as.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "ID of the parent account, used to build account hierarchies.",
+				Optional:    true,
+			},
+			"record_type_id": schema.StringAttribute{
+				Description: "ID of the account's record type. Defaults to the default record type of the owner's profile. Validated against the record types available on Account.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_address": schema.SingleNestedAttribute{
+				Description: "Billing address of the account.",
+				Optional:    true,
+				Attributes:  addressAttributes("billing"),
+			},
+			"shipping_address": schema.SingleNestedAttribute{
+				Description: "Shipping address of the account.",
+				Optional:    true,
+				Attributes:  addressAttributes("shipping"),
+			},
+			"custom_fields": schema.MapAttribute{
+				Description: "Map of custom field API names (ending in __c) to values. Values are given as strings and converted according to the field's type on Account: numbers, true/false for checkboxes, YYYY-MM-DD for dates and RFC 3339 for datetimes, picklist values are validated against the org. Only fields present in config are read back, removing a field stops managing it without clearing its value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"external_id_field": schema.StringAttribute{
+				Description: "API name of an external ID field on Account. When set with external_id, create upserts the account by that value, adopting a matching account created outside of Terraform instead of inserting a duplicate. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "Value of external_id_field identifying the account. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
 
 type accountResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	AccountNumber types.String `tfsdk:"account_number"`
-	Type          types.String `tfsdk:"type"`
-	Industry      types.String `tfsdk:"industry"`
-	Phone         types.String `tfsdk:"phone"`
-	Website       types.String `tfsdk:"website"`
+	Id                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	AccountNumber     types.String  `tfsdk:"account_number"`
+	Type              types.String  `tfsdk:"type"`
+	Industry          types.String  `tfsdk:"industry"`
+	Phone             types.String  `tfsdk:"phone"`
+	Website           types.String  `tfsdk:"website"`
+	Description       types.String  `tfsdk:"description"`
+	AnnualRevenue     types.Float64 `tfsdk:"annual_revenue"`
+	NumberOfEmployees types.Int64   `tfsdk:"number_of_employees"`
+	Rating            types.String  `tfsdk:"rating"`
+	Ownership         types.String  `tfsdk:"ownership"`
+	OwnerId           types.String  `tfsdk:"owner_id"`
+	ParentId          types.String  `tfsdk:"parent_id"`
+	RecordTypeId      types.String  `tfsdk:"record_type_id"`
+	BillingAddress    *addressModel `tfsdk:"billing_address"`
+	ShippingAddress   *addressModel `tfsdk:"shipping_address"`
+	CustomFields      types.Map     `tfsdk:"custom_fields"`
+	ExternalIdField   types.String  `tfsdk:"external_id_field"`
+	ExternalId        types.String  `tfsdk:"external_id"`
 }
 
-// Custom Account struct that implements force.SObject
+// Custom Account struct that implements force.SObject, nil fields are sent as null to clear them
 type customAccount struct {
-	Name            string `json:"Name"`
-	AccountNumber   string `json:"AccountNumber,omitempty"`
-	Type            string `json:"Type,omitempty"`
-	Industry        string `json:"Industry,omitempty"`
-	Phone           string `json:"Phone,omitempty"`
-	Website         string `json:"Website,omitempty"`
+	Name               string   `json:"Name"`
+	AccountNumber      *string  `json:"AccountNumber"`
+	Type               *string  `json:"Type"`
+	Industry           *string  `json:"Industry"`
+	Phone              *string  `json:"Phone"`
+	Website            *string  `json:"Website"`
+	Description        *string  `json:"Description"`
+	AnnualRevenue      *float64 `json:"AnnualRevenue"`
+	NumberOfEmployees  *int64   `json:"NumberOfEmployees"`
+	Rating             *string  `json:"Rating"`
+	Ownership          *string  `json:"Ownership"`
+	ParentId           *string  `json:"ParentId"`
+	BillingStreet      *string  `json:"BillingStreet"`
+	BillingCity        *string  `json:"BillingCity"`
+	BillingState       *string  `json:"BillingState"`
+	BillingPostalCode  *string  `json:"BillingPostalCode"`
+	BillingCountry     *string  `json:"BillingCountry"`
+	ShippingStreet     *string  `json:"ShippingStreet"`
+	ShippingCity       *string  `json:"ShippingCity"`
+	ShippingState      *string  `json:"ShippingState"`
+	ShippingPostalCode *string  `json:"ShippingPostalCode"`
+	ShippingCountry    *string  `json:"ShippingCountry"`
+
+	// owner and record type are left to salesforce's defaults rather than cleared when unset
+	OwnerId      *string `json:"OwnerId,omitempty" force:",omitempty"`
+	RecordTypeId *string `json:"RecordTypeId,omitempty" force:",omitempty"`
+
+	// custom fields are serialized alongside the standard fields
+	CustomFields map[string]interface{} `json:"-" force:"-"`
+
+	externalIdApiName string
 }
 
 func (a customAccount) ApiName() string {
@@ -83,47 +205,345 @@ func (a customAccount) ApiName() string {
 }
 
 func (a customAccount) ExternalIdApiName() string {
-	return ""
+	return a.externalIdApiName
 }
 
-func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+// expandAccount builds the writable Account fields from the plan, custom fields are added separately
+func expandAccount(data accountResourceModel) customAccount {
+	account := customAccount{
+		Name:              data.Name.ValueString(),
+		AccountNumber:     data.AccountNumber.ValueStringPointer(),
+		Type:              data.Type.ValueStringPointer(),
+		Industry:          data.Industry.ValueStringPointer(),
+		Phone:             data.Phone.ValueStringPointer(),
+		Website:           data.Website.ValueStringPointer(),
+		Description:       data.Description.ValueStringPointer(),
+		AnnualRevenue:     data.AnnualRevenue.ValueFloat64Pointer(),
+		NumberOfEmployees: data.NumberOfEmployees.ValueInt64Pointer(),
+		Rating:            data.Rating.ValueStringPointer(),
+		Ownership:         data.Ownership.ValueStringPointer(),
+		ParentId:          data.ParentId.ValueStringPointer(),
+	}
+	if !data.OwnerId.IsUnknown() {
+		account.OwnerId = data.OwnerId.ValueStringPointer()
+	}
+	if !data.RecordTypeId.IsUnknown() {
+		account.RecordTypeId = data.RecordTypeId.ValueStringPointer()
+	}
+	if a := data.BillingAddress; a != nil {
+		account.BillingStreet = a.Street.ValueStringPointer()
+		account.BillingCity = a.City.ValueStringPointer()
+		account.BillingState = a.State.ValueStringPointer()
+		account.BillingPostalCode = a.PostalCode.ValueStringPointer()
+		account.BillingCountry = a.Country.ValueStringPointer()
+	}
+	if a := data.ShippingAddress; a != nil {
+		account.ShippingStreet = a.Street.ValueStringPointer()
+		account.ShippingCity = a.City.ValueStringPointer()
+		account.ShippingState = a.State.ValueStringPointer()
+		account.ShippingPostalCode = a.PostalCode.ValueStringPointer()
+		account.ShippingCountry = a.Country.ValueStringPointer()
+	}
+	return account
+}
+
+// flattenAccount sets the standard fields of the model from an Account read from the API
+func flattenAccount(data *accountResourceModel, account customAccount) {
+	data.Name = types.StringValue(account.Name)
+	data.AccountNumber = types.StringPointerValue(account.AccountNumber)
+	data.Type = types.StringPointerValue(account.Type)
+	data.Industry = types.StringPointerValue(account.Industry)
+	data.Phone = types.StringPointerValue(account.Phone)
+	data.Website = types.StringPointerValue(account.Website)
+	data.Description = types.StringPointerValue(account.Description)
+	data.AnnualRevenue = types.Float64PointerValue(account.AnnualRevenue)
+	data.NumberOfEmployees = types.Int64PointerValue(account.NumberOfEmployees)
+	data.Rating = types.StringPointerValue(account.Rating)
+	data.Ownership = types.StringPointerValue(account.Ownership)
+	data.OwnerId = sameId(data.OwnerId, account.OwnerId)
+	data.ParentId = sameId(data.ParentId, account.ParentId)
+	data.RecordTypeId = sameId(data.RecordTypeId, account.RecordTypeId)
+	data.BillingAddress = flattenAddress(data.BillingAddress, account.BillingStreet, account.BillingCity, account.BillingState, account.BillingPostalCode, account.BillingCountry)
+	data.ShippingAddress = flattenAddress(data.ShippingAddress, account.ShippingStreet, account.ShippingCity, account.ShippingState, account.ShippingPostalCode, account.ShippingCountry)
+}
+
+func (a customAccount) MarshalJSON() ([]byte, error) {
+	// the conversion drops the methods so the standard fields marshal without recursing
+	type standardAccount customAccount
+	data, err := forcejson.Marshal(standardAccount(a))
+	if err != nil || len(a.CustomFields) == 0 {
+		return data, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range a.CustomFields {
+		fields[k] = v
+	}
+	return json.Marshal(fields)
+}
+
+func (a *customAccount) UnmarshalJSON(data []byte) error {
+	type standardAccount customAccount
+	var standard standardAccount
+	if err := forcejson.Unmarshal(data, &standard); err != nil {
+		return err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	standard.CustomFields = make(map[string]interface{})
+	for k, v := range fields {
+		if strings.HasSuffix(k, "__c") {
+			standard.CustomFields[k] = v
+		}
+	}
+	*a = customAccount(standard)
+	return nil
+}
+
+// customFieldValues converts the configured custom_fields into API values according to the Account describe,
+// unknown values are skipped so this can also validate plans
+func (r *accountResource) customFieldValues(customFields types.Map) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if customFields.IsNull() || customFields.IsUnknown() || len(customFields.Elements()) == 0 {
+		return nil, diags
+	}
+
+	desc, err := describeSObject(r.client, "Account")
+	if err != nil {
+		diags.AddError("Error Describing Account", err.Error())
+		return nil, diags
+	}
+	describeFields := sobjectFieldsByName(desc)
+
+	values := make(map[string]interface{}, len(customFields.Elements()))
+	for name, element := range customFields.Elements() {
+		field, ok := describeFields[strings.ToLower(name)]
+		if !ok || !field.Custom {
+			diags.AddAttributeError(
+				path.Root("custom_fields").AtMapKey(name),
+				"Invalid custom field",
+				fmt.Sprintf("Account has no custom field named %s", name),
+			)
+			continue
+		}
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			continue
+		}
+		if value.IsNull() {
+			values[field.Name] = nil
+			continue
+		}
+		v, err := coerceSObjectFieldString(field, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("custom_fields").AtMapKey(name), "Invalid custom field value", err.Error())
+			continue
+		}
+		values[field.Name] = v
+	}
+	return values, diags
+}
+
+func (r *accountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
 	var data accountResourceModel
-	diags := req.Plan.Get(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	_, diags := r.customFieldValues(data.CustomFields)
+	resp.Diagnostics.Append(diags...)
 
-	account := customAccount{
-		Name: data.Name.ValueString(),
+	picklists := map[string]types.String{
+		"type":      data.Type,
+		"industry":  data.Industry,
+		"rating":    data.Rating,
+		"ownership": data.Ownership,
+	}
+	// only describe when there's something to check
+	known := func(v types.String) bool { return !v.IsNull() && !v.IsUnknown() }
+	if known(data.Type) || known(data.Industry) || known(data.Rating) || known(data.Ownership) || known(data.RecordTypeId) {
+		r.validatePicklists(picklists, data.RecordTypeId, resp)
 	}
-	if !data.AccountNumber.IsNull() {
-		account.AccountNumber = data.AccountNumber.ValueString()
+}
+
+// accountPicklistFields maps picklist attributes to the Account fields they set
+var accountPicklistFields = map[string]string{
+	"type":      "Type",
+	"industry":  "Industry",
+	"rating":    "Rating",
+	"ownership": "Ownership",
+}
+
+// validatePicklists checks picklist values against the active values in the org, Account picklists
+// aren't restricted so salesforce would otherwise accept any value silently
+func (r *accountResource) validatePicklists(picklists map[string]types.String, recordTypeId types.String, resp *resource.ModifyPlanResponse) {
+	desc, err := describeSObject(r.client, "Account")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Describing Account", err.Error())
+		return
+	}
+	describeFields := sobjectFieldsByName(desc)
+
+	for name, value := range picklists {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		field, ok := describeFields[strings.ToLower(accountPicklistFields[name])]
+		if !ok {
+			continue
+		}
+		var values []string
+		found := false
+		for _, pv := range field.PicklistValues {
+			if !pv.Active {
+				continue
+			}
+			values = append(values, pv.Value)
+			if pv.Value == value.ValueString() {
+				found = true
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid picklist value",
+				fmt.Sprintf("%s must be one of: [%s]", name, strings.Join(values, ", ")),
+			)
+		}
 	}
-	if !data.Type.IsNull() {
-		account.Type = data.Type.ValueString()
+
+	if recordTypeId.IsNull() || recordTypeId.IsUnknown() {
+		return
 	}
-	if !data.Industry.IsNull() {
-		account.Industry = data.Industry.ValueString()
+	var available []string
+	for _, info := range desc.RecordTypeInfos {
+		if !info.Available {
+			continue
+		}
+		if normalizeId(info.RecordTypeId) == normalizeId(recordTypeId.ValueString()) {
+			return
+		}
+		available = append(available, fmt.Sprintf("%s (%s)", info.RecordTypeId, info.Name))
 	}
-	if !data.Phone.IsNull() {
-		account.Phone = data.Phone.ValueString()
+	resp.Diagnostics.AddAttributeError(
+		path.Root("record_type_id"),
+		"Invalid record type",
+		fmt.Sprintf("record_type_id must be one of the record types available on Account: [%s]", strings.Join(available, ", ")),
+	)
+}
+
+func (r *accountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data accountResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.Website.IsNull() {
-		account.Website = data.Website.ValueString()
+
+	if data.ExternalId.IsNull() != data.ExternalIdField.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("external_id_field"), "Invalid Attribute Combination", "external_id and external_id_field must be set together.")
 	}
+}
 
-	sfResp, err := r.client.InsertSObject(account)
-	if err != nil {
-		resp.Diagnostics.AddError("Error Inserting Account", err.Error())
+func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data accountResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account := expandAccount(data)
+	account.CustomFields, diags = r.customFieldValues(data.CustomFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ExternalId.IsNull() {
+		account.externalIdApiName = data.ExternalIdField.ValueString()
+		id, err := upsertSObject(r.client, account, data.ExternalId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Upserting Account", err.Error())
+			return
+		}
+		data.Id = types.StringValue(id)
+	} else {
+		sfResp, err := r.client.InsertSObject(account)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Inserting Account", err.Error())
+			return
+		}
+		data.Id = types.StringValue(sfResp.Id)
+	}
+
+	// owner and record type default server side, read them back when they weren't configured. The
+	// account exists now, so save it first
+	readOwner, readRecordType := data.OwnerId.IsUnknown(), data.RecordTypeId.IsUnknown()
+	if readOwner {
+		data.OwnerId = types.StringNull()
+	}
+	if readRecordType {
+		data.RecordTypeId = types.StringNull()
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.Id = types.StringValue(sfResp.Id)
+	resp.Diagnostics.Append(r.readDefaults(&data, readOwner, readRecordType)...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// readDefaults reads back the owner and record type of a saved account when they weren't known
+// in the plan. Failures are only warnings since the account exists, Read fills them in on the next refresh
+func (r *accountResource) readDefaults(data *accountResourceModel, readOwner, readRecordType bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var fieldNames []string
+	if readOwner {
+		data.OwnerId = types.StringNull()
+		fieldNames = append(fieldNames, "OwnerId")
+	}
+	if readRecordType {
+		data.RecordTypeId = types.StringNull()
+		desc, err := describeSObject(r.client, "Account")
+		if err != nil {
+			diags.AddWarning("Error Describing Account", fmt.Sprintf("The account was saved but its record type couldn't be read: %s", err))
+			return diags
+		}
+		// RecordTypeId only exists once the org has record types on Account
+		if len(desc.RecordTypeInfos) > 1 {
+			fieldNames = append(fieldNames, "RecordTypeId")
+		}
+	}
+	if len(fieldNames) == 0 {
+		return diags
+	}
+
+	var saved customAccount
+	if err := r.client.GetSObject(data.Id.ValueString(), fieldNames, &saved); err != nil {
+		diags.AddWarning("Error Getting Account", fmt.Sprintf("The account was saved but its %s couldn't be read: %s", strings.Join(fieldNames, " and "), err))
+		return diags
+	}
+	if readOwner {
+		data.OwnerId = types.StringPointerValue(saved.OwnerId)
+	}
+	if contains(fieldNames, "RecordTypeId") {
+		data.RecordTypeId = types.StringPointerValue(saved.RecordTypeId)
+	}
+	return diags
+}
+
 func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data accountResourceModel
 	diags := req.State.Get(ctx, &data)
@@ -138,12 +558,28 @@ func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	data.Name = types.StringValue(account.Name)
-	data.AccountNumber = types.StringValue(account.AccountNumber)
-	data.Type = types.StringValue(account.Type)
-	data.Industry = types.StringValue(account.Industry)
-	data.Phone = types.StringValue(account.Phone)
-	data.Website = types.StringValue(account.Website)
+	flattenAccount(&data, account)
+
+	// only track the custom fields in config so unmanaged fields don't produce diffs
+	if !data.CustomFields.IsNull() {
+		desc, err := describeSObject(r.client, "Account")
+		if err != nil {
+			resp.Diagnostics.AddError("Error Describing Account", err.Error())
+			return
+		}
+		describeFields := sobjectFieldsByName(desc)
+		customFields := make(map[string]attr.Value, len(data.CustomFields.Elements()))
+		for name, prior := range data.CustomFields.Elements() {
+			raw, _ := lookupSObjectField(account.CustomFields, name)
+			v, err := sobjectValueFromInterface(raw, prior, describeFields[strings.ToLower(name)])
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("custom_fields").AtMapKey(name), "Error reading custom field", err.Error())
+				return
+			}
+			customFields[name] = v
+		}
+		data.CustomFields = types.MapValueMust(types.StringType, customFields)
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -157,29 +593,19 @@ func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	account := customAccount{
-		Name: data.Name.ValueString(),
-	}
-	if !data.AccountNumber.IsNull() {
-		account.AccountNumber = data.AccountNumber.ValueString()
-	}
-	if !data.Type.IsNull() {
-		account.Type = data.Type.ValueString()
-	}
-	if !data.Industry.IsNull() {
-		account.Industry = data.Industry.ValueString()
-	}
-	if !data.Phone.IsNull() {
-		account.Phone = data.Phone.ValueString()
-	}
-	if !data.Website.IsNull() {
-		account.Website = data.Website.ValueString()
+	account := expandAccount(data)
+	account.CustomFields, diags = r.customFieldValues(data.CustomFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if err := r.client.UpdateSObject(data.Id.ValueString(), account); err != nil {
 		resp.Diagnostics.AddError("Error Updating Account", err.Error())
 		return
 	}
+	// owner and record type are unknown when they were never read, such as in orgs without record types
+	resp.Diagnostics.Append(r.readDefaults(&data, data.OwnerId.IsUnknown(), data.RecordTypeId.IsUnknown())...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -193,8 +619,34 @@ func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if err := r.client.DeleteSObject(data.Id.ValueString(), nil); err != nil {
+	if err := r.client.DeleteSObject(data.Id.ValueString(), customAccount{}); err != nil {
 		resp.Diagnostics.AddError("Error Deleting Account", err.Error())
 		return
 	}
-} 
\ No newline at end of file
+}
+
+func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	externalIdField, externalId, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), normalizeId(req.ID))...)
+		return
+	}
+	if externalIdField == "" || externalId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id or external_id_field/external_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	existing := dynamicSObject{apiName: "Account", externalIdApiName: externalIdField}
+	if err := r.client.GetSObjectByExternalId(url.PathEscape(externalId), []string{"Id"}, &existing); err != nil {
+		resp.Diagnostics.AddError("Error Getting Account", err.Error())
+		return
+	}
+	raw, _ := lookupSObjectField(existing.fields, "Id")
+	id, _ := raw.(string)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external_id_field"), externalIdField)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external_id"), externalId)...)
+}
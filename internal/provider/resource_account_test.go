@@ -5,6 +5,8 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -63,4 +65,138 @@ resource "salesforce_account" "test" {
   website  = "https://example.com"
 }
 `, name)
-} 
\ No newline at end of file
+}
+
+func TestAccAccountResource_externalId(t *testing.T) {
+	externalIdField := os.Getenv("SALESFORCE_ACCOUNT_EXTERNAL_ID_FIELD")
+	if externalIdField == "" {
+		t.Skip("SALESFORCE_ACCOUNT_EXTERNAL_ID_FIELD must name an external ID field on Account to run this test")
+	}
+	externalId := RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountResourceConfigExternalId(externalIdField, externalId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("salesforce_account.test", "id"),
+					resource.TestCheckResourceAttr("salesforce_account.test", "external_id", externalId),
+				),
+			},
+			{
+				ResourceName:      "salesforce_account.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", externalIdField, externalId),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccountResourceConfigExternalId(externalIdField, externalId string) string {
+	return fmt.Sprintf(`
+resource "salesforce_account" "test" {
+  name              = "External ID Test Account"
+  external_id_field = %[1]q
+  external_id       = %[2]q
+}
+`, externalIdField, externalId)
+}
+
+func TestAccAccountResource_invalidCustomField(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "salesforce_account" "test" {
+  name = "Invalid Custom Field Test Account"
+  custom_fields = {
+    Not_A_Field__c = "value"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Account has no custom field named Not_A_Field__c"),
+			},
+		},
+	})
+}
+
+func TestAccAccountResource_details(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountResourceDetailsConfig("Hot", 125),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_account.test", "rating", "Hot"),
+					resource.TestCheckResourceAttr("salesforce_account.test", "number_of_employees", "125"),
+					resource.TestCheckResourceAttr("salesforce_account.test", "annual_revenue", "1500000.5"),
+					resource.TestCheckResourceAttr("salesforce_account.test", "billing_address.city", "San Francisco"),
+					resource.TestCheckResourceAttrPair("salesforce_account.test", "parent_id", "salesforce_account.parent", "id"),
+					resource.TestCheckResourceAttrSet("salesforce_account.test", "owner_id"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountResourceDetailsConfig("Cold", 130),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_account.test", "rating", "Cold"),
+					resource.TestCheckResourceAttr("salesforce_account.test", "number_of_employees", "130"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountResource_invalidPicklist(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "salesforce_account" "test" {
+  name   = "Invalid Rating"
+  rating = "Lukewarm"
+}
+`,
+				ExpectError: regexp.MustCompile("rating must be one of"),
+			},
+		},
+	})
+}
+
+func testAccAccountResourceDetailsConfig(rating string, employees int) string {
+	return fmt.Sprintf(`
+resource "salesforce_account" "parent" {
+  name = "Parent Account"
+}
+
+resource "salesforce_account" "test" {
+  name                = "Child Account"
+  description         = "Child of the parent account"
+  rating              = %[1]q
+  ownership           = "Private"
+  annual_revenue      = 1500000.5
+  number_of_employees = %[2]d
+  parent_id           = salesforce_account.parent.id
+
+  billing_address = {
+    street      = "415 Mission Street"
+    city        = "San Francisco"
+    state       = "CA"
+    postal_code = "94105"
+    country     = "USA"
+  }
+}
+`, rating, employees)
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+// cspTrustedSiteAttributes maps the fields named by Metadata API errors to attributes
+var cspTrustedSiteAttributes = map[string]path.Path{
+	"context":     path.Root("context"),
+	"endpointUrl": path.Root("url"),
+	"fullName":    path.Root("name"),
+}
+
+var cspTrustedSiteDefaults = resourceDefaults{
+	defaults: map[string]attr.Value{
+		path.Root("context").String(): types.StringValue("All"),
+	},
+}
+
+type cspTrustedSiteResource struct {
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                = &cspTrustedSiteResource{}
+	_ resource.ResourceWithImportState = &cspTrustedSiteResource{}
+)
+
+func (r *cspTrustedSiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_csp_trusted_site"
+}
+
+// cspDirectiveAttribute is an attribute enabling a content security policy directive for the site, enabled by default
+func cspDirectiveAttribute(directive, resources string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether the site is allowed by the %s directive, to load %s. Defaults to true.", directive, resources),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			booleanNilIsTrue{},
+		},
+	}
+}
+
+func (r *cspTrustedSiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "CSP Trusted Site Resource for the Salesforce Provider, allows Lightning pages to load resources from a site, managed through the Metadata API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the same as name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the trusted site. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the site, a scheme and host such as https://cdn.example.com, optionally with a port. The host may start with *. to trust its subdomains.",
+				Required:    true,
+				Validators: []validator.String{
+					siteURL{schemes: []string{"https", "http", "wss"}, wildcard: true},
+				},
+			},
+			"context": schema.StringAttribute{
+				Description: "Where the site is trusted, one of All, LEX for Lightning Experience, Communities or VisualForce. Defaults to All.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"All", "LEX", "Communities", "VisualForce"}},
+				},
+				PlanModifiers: []planmodifier.String{
+					cspTrustedSiteDefaults,
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the site is trusted. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsTrue{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the trusted site.",
+				Optional:    true,
+			},
+			"connect_src": cspDirectiveAttribute("connect-src", "data with fetch, XMLHttpRequest and WebSockets"),
+			"font_src":    cspDirectiveAttribute("font-src", "fonts"),
+			"frame_src":   cspDirectiveAttribute("frame-src", "iframes"),
+			"img_src":     cspDirectiveAttribute("img-src", "images"),
+			"media_src":   cspDirectiveAttribute("media-src", "audio and video"),
+			"style_src":   cspDirectiveAttribute("style-src", "stylesheets"),
+			"can_access_camera": schema.BoolAttribute{
+				Description: "Whether pages in the site's iframes can access the camera. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"can_access_microphone": schema.BoolAttribute{
+				Description: "Whether pages in the site's iframes can access the microphone. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+		},
+	}
+}
+
+type cspTrustedSiteResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Url                 types.String `tfsdk:"url"`
+	Context             types.String `tfsdk:"context"`
+	Active              types.Bool   `tfsdk:"active"`
+	Description         types.String `tfsdk:"description"`
+	ConnectSrc          types.Bool   `tfsdk:"connect_src"`
+	FontSrc             types.Bool   `tfsdk:"font_src"`
+	FrameSrc            types.Bool   `tfsdk:"frame_src"`
+	ImgSrc              types.Bool   `tfsdk:"img_src"`
+	MediaSrc            types.Bool   `tfsdk:"media_src"`
+	StyleSrc            types.Bool   `tfsdk:"style_src"`
+	CanAccessCamera     types.Bool   `tfsdk:"can_access_camera"`
+	CanAccessMicrophone types.Bool   `tfsdk:"can_access_microphone"`
+}
+
+func expandCspTrustedSite(data cspTrustedSiteResourceModel) metadata.CspTrustedSite {
+	return metadata.CspTrustedSite{
+		FullName:                 data.Name.ValueString(),
+		CanAccessCamera:          data.CanAccessCamera.ValueBool(),
+		CanAccessMicrophone:      data.CanAccessMicrophone.ValueBool(),
+		Context:                  data.Context.ValueString(),
+		Description:              data.Description.ValueString(),
+		EndpointUrl:              data.Url.ValueString(),
+		IsActive:                 data.Active.ValueBool(),
+		IsApplicableToConnectSrc: data.ConnectSrc.ValueBool(),
+		IsApplicableToFontSrc:    data.FontSrc.ValueBool(),
+		IsApplicableToFrameSrc:   data.FrameSrc.ValueBool(),
+		IsApplicableToImgSrc:     data.ImgSrc.ValueBool(),
+		IsApplicableToMediaSrc:   data.MediaSrc.ValueBool(),
+		IsApplicableToStyleSrc:   data.StyleSrc.ValueBool(),
+	}
+}
+
+func flattenCspTrustedSite(data *cspTrustedSiteResourceModel, site metadata.CspTrustedSite) {
+	data.Id = types.StringValue(site.FullName)
+	data.Name = types.StringValue(site.FullName)
+	data.Url = types.StringValue(site.EndpointUrl)
+	// sites created before contexts were introduced have none, they apply to all
+	data.Context = types.StringValue("All")
+	if site.Context != "" {
+		data.Context = types.StringValue(site.Context)
+	}
+	data.Active = types.BoolValue(site.IsActive)
+	data.Description = metadataString(site.Description)
+	data.ConnectSrc = types.BoolValue(site.IsApplicableToConnectSrc)
+	data.FontSrc = types.BoolValue(site.IsApplicableToFontSrc)
+	data.FrameSrc = types.BoolValue(site.IsApplicableToFrameSrc)
+	data.ImgSrc = types.BoolValue(site.IsApplicableToImgSrc)
+	data.MediaSrc = types.BoolValue(site.IsApplicableToMediaSrc)
+	data.StyleSrc = types.BoolValue(site.IsApplicableToStyleSrc)
+	data.CanAccessCamera = types.BoolValue(site.CanAccessCamera)
+	data.CanAccessMicrophone = types.BoolValue(site.CanAccessMicrophone)
+}
+
+func (r *cspTrustedSiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cspTrustedSiteResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Create(ctx, expandCspTrustedSite(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating CSP Trusted Site", err, cspTrustedSiteAttributes)...)
+		return
+	}
+	data.Id = data.Name
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cspTrustedSiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cspTrustedSiteResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, found, err := metadata.Read[metadata.CspTrustedSite](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading CSP Trusted Site", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenCspTrustedSite(&data, site)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cspTrustedSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data cspTrustedSiteResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Update(ctx, expandCspTrustedSite(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating CSP Trusted Site", err, cspTrustedSiteAttributes)...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cspTrustedSiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cspTrustedSiteResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "CspTrustedSite", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting CSP Trusted Site", err.Error())
+		return
+	}
+}
+
+func (r *cspTrustedSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if checkCustomName(req.ID, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Trusted_Site_Name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func TestFlattenCspTrustedSite(t *testing.T) {
+	t.Parallel()
+
+	var data cspTrustedSiteResourceModel
+	flattenCspTrustedSite(&data, metadata.CspTrustedSite{
+		FullName:             "Maps_Tiles",
+		EndpointUrl:          "https://*.tiles.example.com",
+		IsActive:             true,
+		IsApplicableToImgSrc: true,
+	})
+	if data.Context.ValueString() != "All" {
+		t.Errorf("expected a site without a context to apply to All, got %s", data.Context)
+	}
+	if !data.ImgSrc.ValueBool() || data.ConnectSrc.ValueBool() || !data.Description.Equal(types.StringNull()) {
+		t.Errorf("unexpected site %+v", data)
+	}
+}
+
+func TestAccResourceCspTrustedSite_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCspTrustedSite_basic(name, "All"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_csp_trusted_site.test", "id", name),
+					resource.TestCheckResourceAttr("salesforce_csp_trusted_site.test", "context", "All"),
+					resource.TestCheckResourceAttr("salesforce_csp_trusted_site.test", "img_src", "true"),
+					resource.TestCheckResourceAttr("salesforce_csp_trusted_site.test", "frame_src", "false"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_csp_trusted_site.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceCspTrustedSite_basic(name, "LEX"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_csp_trusted_site.test", "context", "LEX"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCspTrustedSite_basic(name, context string) string {
+	return fmt.Sprintf(`
+resource "salesforce_csp_trusted_site" "test" {
+  name      = "%s"
+  url       = "https://*.tiles.example.com"
+  context   = "%s"
+  frame_src = false
+}
+`, name, context)
+}
@@ -0,0 +1,796 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+var customFieldTypes = []string{
+	"Text", "Number", "Currency", "Checkbox", "Date", "DateTime", "Email", "Phone",
+	"Picklist", "Lookup", "MasterDetail", "Formula", "Summary",
+}
+
+// customFieldTypeAttributes lists the type specific attributes each type requires and allows,
+// the booleans among them are only checked when true as they default to false
+var customFieldTypeAttributes = map[string]struct {
+	required []string
+	optional []string
+}{
+	"Text":         {required: []string{"length"}, optional: []string{"default_value", "required", "unique", "external_id"}},
+	"Number":       {required: []string{"precision", "scale"}, optional: []string{"default_value", "required", "unique", "external_id"}},
+	"Currency":     {required: []string{"precision", "scale"}, optional: []string{"default_value", "required"}},
+	"Checkbox":     {optional: []string{"default_value"}},
+	"Date":         {optional: []string{"default_value", "required"}},
+	"DateTime":     {optional: []string{"default_value", "required"}},
+	"Email":        {optional: []string{"default_value", "required", "unique", "external_id"}},
+	"Phone":        {optional: []string{"default_value", "required"}},
+	"Picklist":     {required: []string{"value_set"}, optional: []string{"required"}},
+	"Lookup":       {required: []string{"reference_to", "relationship_name"}, optional: []string{"relationship_label", "delete_constraint", "required"}},
+	"MasterDetail": {required: []string{"reference_to", "relationship_name"}, optional: []string{"relationship_label"}},
+	"Formula":      {required: []string{"formula", "formula_return_type"}, optional: []string{"formula_treat_blanks_as", "precision", "scale"}},
+	"Summary":      {required: []string{"summary_foreign_key", "summary_operation"}, optional: []string{"summarized_field"}},
+}
+
+// customFieldTypeGroup groups the types Salesforce converts between, changing to another group forces replacement
+func customFieldTypeGroup(fieldType string) string {
+	switch fieldType {
+	case "Text", "Number", "Currency", "Date", "DateTime", "Email", "Phone", "Picklist":
+		return "value"
+	case "Lookup", "MasterDetail":
+		return "relationship"
+	}
+	return fieldType
+}
+
+type customFieldResource struct {
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                   = &customFieldResource{}
+	_ resource.ResourceWithValidateConfig = &customFieldResource{}
+	_ resource.ResourceWithImportState    = &customFieldResource{}
+)
+
+func (r *customFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_custom_field"
+}
+
+func (r *customFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Custom Field Resource for the Salesforce Provider, managed through the Metadata API. The attributes that apply depend on the type. Field level security isn't granted by creating a field, grant it to profiles or permission sets for the field to be visible.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the full name of the field in the format Object.Field__c.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object": schema.StringAttribute{
+				Description: "API name of the object of the field, such as Account or Widget__c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_name": schema.StringAttribute{
+				Description: "API name of the field, ending with __c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{suffix: "__c"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the field in the user interface.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the field, one of %s. Salesforce converts between Text, Number, Currency, Date, DateTime, Email, Phone and Picklist, and between Lookup and MasterDetail, other type changes force replacement, losing the data of the field.", strings.Join(customFieldTypes, ", ")),
+				Required:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: customFieldTypes},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = customFieldTypeGroup(req.StateValue.ValueString()) != customFieldTypeGroup(req.PlanValue.ValueString())
+						},
+						"Changing the type forces replacement unless Salesforce can convert the field.",
+						"Changing the type forces replacement unless Salesforce can convert the field.",
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the field.",
+				Optional:    true,
+			},
+			"inline_help_text": schema.StringAttribute{
+				Description: "Help text shown next to the field in the user interface.",
+				Optional:    true,
+			},
+			"default_value": schema.StringAttribute{
+				Description: "Default value of the field as a formula expression, such as a quoted \"string\", 0 or TODAY(). Text, Number, Currency, Checkbox, Date, DateTime, Email and Phone only. A Checkbox defaults to false.",
+				Optional:    true,
+			},
+			"required": schema.BoolAttribute{
+				Description: "Whether the field requires a value on every record. Not available for Checkbox, MasterDetail (always required), Formula and Summary. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"unique": schema.BoolAttribute{
+				Description: "Whether values must be unique across records. Text, Number and Email only. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"external_id": schema.BoolAttribute{
+				Description: "Whether the field is an external ID, indexed for upserts and lookups. Text, Number and Email only. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: "Maximum number of characters of a Text field, from 1 to 255. Required for Text.",
+				Optional:    true,
+			},
+			"precision": schema.Int64Attribute{
+				Description: "Total number of digits of a Number or Currency field, or a Formula with a numeric result, from 1 to 18. Required for Number and Currency.",
+				Optional:    true,
+			},
+			"scale": schema.Int64Attribute{
+				Description: "Number of digits after the decimal point, at most precision. Required for Number and Currency.",
+				Optional:    true,
+			},
+			"value_set": schema.SingleNestedAttribute{
+				Description: "Values of a Picklist field, either values defined on the field or a global value set. Required for Picklist.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"global_value_set": schema.StringAttribute{
+						Description: "Name of the global value set to use instead of values.",
+						Optional:    true,
+					},
+					"restricted": schema.BoolAttribute{
+						Description: "Whether only the defined values are accepted. Always true with a global value set.",
+						Optional:    true,
+					},
+					"sorted": schema.BoolAttribute{
+						Description: "Whether the values are displayed alphabetically instead of in order.",
+						Optional:    true,
+					},
+					"values": schema.ListNestedAttribute{
+						Description: "Values of the picklist, in display order.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.StringAttribute{
+									Description: "API name of the value, stored on records.",
+									Required:    true,
+									Validators: []validator.String{
+										notEmptyString{},
+									},
+								},
+								"label": schema.StringAttribute{
+									Description: "Label of the value in the user interface, defaults to the value.",
+									Optional:    true,
+								},
+								"default": schema.BoolAttribute{
+									Description: "Whether the value is selected by default on new records.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"reference_to": schema.StringAttribute{
+				Description: "API name of the object a Lookup or MasterDetail field refers to. Required for Lookup and MasterDetail. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"relationship_name": schema.StringAttribute{
+				Description: "API name of the relationship used in queries from the parent, without the __r suffix. Required for Lookup and MasterDetail.",
+				Optional:    true,
+			},
+			"relationship_label": schema.StringAttribute{
+				Description: "Label of the related list on the parent object. Defaults to the plural label of the object.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_constraint": schema.StringAttribute{
+				Description: "What happens to a record when the record its Lookup field refers to is deleted, one of SetNull, Restrict or Cascade. Salesforce defaults to SetNull.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"SetNull", "Restrict", "Cascade"}, optional: true},
+				},
+			},
+			"formula": schema.StringAttribute{
+				Description: "Formula calculating the value of a Formula field. Required for Formula.",
+				Optional:    true,
+			},
+			"formula_return_type": schema.StringAttribute{
+				Description: "Type of the result of a Formula field, one of Text, Number, Currency, Percent, Checkbox, Date or DateTime. Required for Formula.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"Text", "Number", "Currency", "Percent", "Checkbox", "Date", "DateTime"}, optional: true},
+				},
+			},
+			"formula_treat_blanks_as": schema.StringAttribute{
+				Description: "How a Formula field treats blank fields, BlankAsZero or BlankAsBlank. Salesforce defaults to BlankAsZero.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"BlankAsZero", "BlankAsBlank"}, optional: true},
+				},
+			},
+			"summary_foreign_key": schema.StringAttribute{
+				Description: "MasterDetail field of the child object a Summary field rolls up, in the format Child__c.Parent__c. Required for Summary.",
+				Optional:    true,
+			},
+			"summary_operation": schema.StringAttribute{
+				Description: "Roll-up operation of a Summary field, one of count, sum, min or max. Required for Summary.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"count", "sum", "min", "max"}, optional: true},
+				},
+			},
+			"summarized_field": schema.StringAttribute{
+				Description: "Field of the child object aggregated by a Summary field, in the format Child__c.Amount__c. Required for Summary unless summary_operation is count.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+type customFieldResourceModel struct {
+	Id                   types.String              `tfsdk:"id"`
+	Object               types.String              `tfsdk:"object"`
+	ApiName              types.String              `tfsdk:"api_name"`
+	Label                types.String              `tfsdk:"label"`
+	Type                 types.String              `tfsdk:"type"`
+	Description          types.String              `tfsdk:"description"`
+	InlineHelpText       types.String              `tfsdk:"inline_help_text"`
+	DefaultValue         types.String              `tfsdk:"default_value"`
+	Required             types.Bool                `tfsdk:"required"`
+	Unique               types.Bool                `tfsdk:"unique"`
+	ExternalId           types.Bool                `tfsdk:"external_id"`
+	Length               types.Int64               `tfsdk:"length"`
+	Precision            types.Int64               `tfsdk:"precision"`
+	Scale                types.Int64               `tfsdk:"scale"`
+	ValueSet             *customFieldValueSetModel `tfsdk:"value_set"`
+	ReferenceTo          types.String              `tfsdk:"reference_to"`
+	RelationshipName     types.String              `tfsdk:"relationship_name"`
+	RelationshipLabel    types.String              `tfsdk:"relationship_label"`
+	DeleteConstraint     types.String              `tfsdk:"delete_constraint"`
+	Formula              types.String              `tfsdk:"formula"`
+	FormulaReturnType    types.String              `tfsdk:"formula_return_type"`
+	FormulaTreatBlanksAs types.String              `tfsdk:"formula_treat_blanks_as"`
+	SummaryForeignKey    types.String              `tfsdk:"summary_foreign_key"`
+	SummaryOperation     types.String              `tfsdk:"summary_operation"`
+	SummarizedField      types.String              `tfsdk:"summarized_field"`
+}
+
+type customFieldValueSetModel struct {
+	GlobalValueSet types.String         `tfsdk:"global_value_set"`
+	Restricted     types.Bool           `tfsdk:"restricted"`
+	Sorted         types.Bool           `tfsdk:"sorted"`
+	Values         []picklistValueModel `tfsdk:"values"`
+}
+
+type picklistValueModel struct {
+	Value   types.String `tfsdk:"value"`
+	Label   types.String `tfsdk:"label"`
+	Default types.Bool   `tfsdk:"default"`
+}
+
+// typeAttributesSet reports which type specific attributes are set, for checking them against customFieldTypeAttributes.
+// The booleans only count when true, as they default to false.
+func (data customFieldResourceModel) typeAttributesSet() map[string]bool {
+	set := map[string]bool{
+		"value_set": data.ValueSet != nil,
+	}
+	for name, value := range data.typeBools() {
+		set[name] = value.IsUnknown() || value.ValueBool()
+	}
+	for name, value := range map[string]attr.Value{
+		"default_value":           data.DefaultValue,
+		"length":                  data.Length,
+		"precision":               data.Precision,
+		"scale":                   data.Scale,
+		"reference_to":            data.ReferenceTo,
+		"relationship_name":       data.RelationshipName,
+		"relationship_label":      data.RelationshipLabel,
+		"delete_constraint":       data.DeleteConstraint,
+		"formula":                 data.Formula,
+		"formula_return_type":     data.FormulaReturnType,
+		"formula_treat_blanks_as": data.FormulaTreatBlanksAs,
+		"summary_foreign_key":     data.SummaryForeignKey,
+		"summary_operation":       data.SummaryOperation,
+		"summarized_field":        data.SummarizedField,
+	} {
+		set[name] = !value.IsNull()
+	}
+	return set
+}
+
+func (data customFieldResourceModel) typeBools() map[string]types.Bool {
+	return map[string]types.Bool{
+		"required":    data.Required,
+		"unique":      data.Unique,
+		"external_id": data.ExternalId,
+	}
+}
+
+func (r *customFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data customFieldResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+	fieldType := data.Type.ValueString()
+	allowed, ok := customFieldTypeAttributes[fieldType]
+	if !ok {
+		// reported by the type validator
+		return
+	}
+
+	set := data.typeAttributesSet()
+	for _, name := range allowed.required {
+		if !set[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing required attribute",
+				fmt.Sprintf("%s must be set for a %s field.", name, fieldType),
+			)
+		}
+	}
+	for name, isSet := range set {
+		if !isSet || contains(allowed.required, name) || contains(allowed.optional, name) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s can't be set for a %s field.", name, fieldType),
+		)
+	}
+
+	r.validateTypeValues(data, resp)
+}
+
+// validateTypeValues checks the ranges and combinations of the type specific attributes that are set
+func (r *customFieldResource) validateTypeValues(data customFieldResourceModel, resp *resource.ValidateConfigResponse) {
+	fieldType := data.Type.ValueString()
+
+	if !data.Length.IsNull() && !data.Length.IsUnknown() {
+		if length := data.Length.ValueInt64(); length < 1 || length > 255 {
+			resp.Diagnostics.AddAttributeError(path.Root("length"), "Invalid length", fmt.Sprintf("length must be from 1 to 255, got %d.", length))
+		}
+	}
+	if !data.Precision.IsNull() && !data.Precision.IsUnknown() {
+		precision := data.Precision.ValueInt64()
+		if precision < 1 || precision > 18 {
+			resp.Diagnostics.AddAttributeError(path.Root("precision"), "Invalid precision", fmt.Sprintf("precision must be from 1 to 18, got %d.", precision))
+		}
+		if !data.Scale.IsNull() && !data.Scale.IsUnknown() {
+			if scale := data.Scale.ValueInt64(); scale < 0 || scale > precision {
+				resp.Diagnostics.AddAttributeError(path.Root("scale"), "Invalid scale", fmt.Sprintf("scale must be from 0 to precision (%d), got %d.", precision, scale))
+			}
+		}
+	}
+
+	if fieldType == "Formula" && !data.FormulaReturnType.IsUnknown() {
+		switch data.FormulaReturnType.ValueString() {
+		case "Number", "Currency", "Percent":
+			for _, name := range []string{"precision", "scale"} {
+				if !data.typeAttributesSet()[name] {
+					resp.Diagnostics.AddAttributeError(
+						path.Root(name),
+						"Missing required attribute",
+						fmt.Sprintf("%s must be set for a Formula field returning %s.", name, data.FormulaReturnType.ValueString()),
+					)
+				}
+			}
+		}
+	}
+
+	if fieldType == "Summary" && !data.SummaryOperation.IsNull() && !data.SummaryOperation.IsUnknown() {
+		isCount := data.SummaryOperation.ValueString() == "count"
+		if isCount && !data.SummarizedField.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("summarized_field"), "Invalid Attribute Combination", "summarized_field can't be set for a count.")
+		}
+		if !isCount && data.SummarizedField.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("summarized_field"), "Missing required attribute", fmt.Sprintf("summarized_field must be set for a %s.", data.SummaryOperation.ValueString()))
+		}
+	}
+
+	if fieldType == "Picklist" && data.ValueSet != nil {
+		valueSet := data.ValueSet
+		if valueSet.GlobalValueSet.IsNull() == (valueSet.Values == nil) {
+			resp.Diagnostics.AddAttributeError(path.Root("value_set"), "Invalid Attribute Combination", "Exactly one of global_value_set and values must be set.")
+		}
+		if !valueSet.GlobalValueSet.IsNull() && !valueSet.Sorted.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("value_set").AtName("sorted"), "Invalid Attribute Combination", "sorted is defined by the global value set.")
+		}
+		if !valueSet.GlobalValueSet.IsNull() && !valueSet.Restricted.IsNull() && !valueSet.Restricted.IsUnknown() && !valueSet.Restricted.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("value_set").AtName("restricted"), "Invalid Attribute Combination", "A picklist using a global value set is always restricted.")
+		}
+		seen := make(map[string]bool)
+		defaults := 0
+		for i, v := range valueSet.Values {
+			if v.Value.IsUnknown() {
+				continue
+			}
+			// the API names of values are case insensitive
+			key := strings.ToLower(v.Value.ValueString())
+			if seen[key] {
+				resp.Diagnostics.AddAttributeError(path.Root("value_set").AtName("values").AtListIndex(i), "Duplicate picklist value", fmt.Sprintf("%q is listed more than once.", v.Value.ValueString()))
+			}
+			seen[key] = true
+			if v.Default.ValueBool() {
+				defaults++
+			}
+		}
+		if defaults > 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("value_set").AtName("values"), "Invalid picklist values", "At most one value can be the default.")
+		}
+	}
+}
+
+func contains(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func expandCustomField(data customFieldResourceModel) metadata.CustomField {
+	field := metadata.CustomField{
+		FullName:             data.Object.ValueString() + "." + data.ApiName.ValueString(),
+		Label:                data.Label.ValueString(),
+		Type:                 data.Type.ValueString(),
+		Description:          data.Description.ValueString(),
+		InlineHelpText:       data.InlineHelpText.ValueString(),
+		DefaultValue:         data.DefaultValue.ValueString(),
+		Length:               data.Length.ValueInt64Pointer(),
+		Precision:            data.Precision.ValueInt64Pointer(),
+		Scale:                data.Scale.ValueInt64Pointer(),
+		ReferenceTo:          data.ReferenceTo.ValueString(),
+		RelationshipName:     data.RelationshipName.ValueString(),
+		RelationshipLabel:    data.RelationshipLabel.ValueString(),
+		DeleteConstraint:     data.DeleteConstraint.ValueString(),
+		Formula:              data.Formula.ValueString(),
+		FormulaTreatBlanksAs: data.FormulaTreatBlanksAs.ValueString(),
+		SummaryForeignKey:    data.SummaryForeignKey.ValueString(),
+		SummaryOperation:     data.SummaryOperation.ValueString(),
+		SummarizedField:      data.SummarizedField.ValueString(),
+	}
+	allowed := customFieldTypeAttributes[field.Type]
+	bools := data.typeBools()
+	for name, target := range map[string]**bool{"required": &field.Required, "unique": &field.Unique, "external_id": &field.ExternalId} {
+		if contains(allowed.optional, name) {
+			*target = boolPointer(bools[name].ValueBool())
+		}
+	}
+
+	switch field.Type {
+	case "Formula":
+		field.Type = data.FormulaReturnType.ValueString()
+	case "Checkbox":
+		// a checkbox needs a default
+		if field.DefaultValue == "" {
+			field.DefaultValue = "false"
+		}
+	case "Picklist":
+		field.ValueSet = expandValueSet(data.ValueSet)
+	}
+	return field
+}
+
+// keepUnmanagedSettings copies the settings that aren't managed from the current field, since an update
+// replaces the whole field. Settings that only apply to some types are only kept when the type doesn't change
+func keepUnmanagedSettings(field *metadata.CustomField, current metadata.CustomField) {
+	field.BusinessOwnerGroup = current.BusinessOwnerGroup
+	field.BusinessOwnerUser = current.BusinessOwnerUser
+	field.BusinessStatus = current.BusinessStatus
+	field.ComplianceGroup = current.ComplianceGroup
+	field.LookupFilter = current.LookupFilter
+	field.SecurityClassification = current.SecurityClassification
+	field.TrackFeedHistory = current.TrackFeedHistory
+	field.TrackHistory = current.TrackHistory
+	field.TrackTrending = current.TrackTrending
+	if field.Type != current.Type {
+		return
+	}
+	field.CaseSensitive = current.CaseSensitive
+	field.EncryptionScheme = current.EncryptionScheme
+	field.MaskChar = current.MaskChar
+	field.MaskType = current.MaskType
+	field.ReparentableMasterDetail = current.ReparentableMasterDetail
+	field.SummaryFilterItems = current.SummaryFilterItems
+	field.VisibleLines = current.VisibleLines
+	field.WriteRequiresMasterRead = current.WriteRequiresMasterRead
+}
+
+func expandValueSet(data *customFieldValueSetModel) *metadata.ValueSet {
+	if data == nil {
+		return nil
+	}
+	if !data.GlobalValueSet.IsNull() {
+		return &metadata.ValueSet{
+			ValueSetName: data.GlobalValueSet.ValueString(),
+			Restricted:   boolPointer(true),
+		}
+	}
+	definition := &metadata.ValueSetDefinition{
+		Sorted: data.Sorted.ValueBool(),
+	}
+	for _, v := range data.Values {
+		label := v.Label.ValueString()
+		if v.Label.IsNull() {
+			label = v.Value.ValueString()
+		}
+		definition.Values = append(definition.Values, metadata.CustomValue{
+			FullName: v.Value.ValueString(),
+			Label:    label,
+			Default:  v.Default.ValueBool(),
+		})
+	}
+	return &metadata.ValueSet{
+		Restricted:         boolPointer(data.Restricted.ValueBool()),
+		ValueSetDefinition: definition,
+	}
+}
+
+func flattenCustomField(data *customFieldResourceModel, field metadata.CustomField) {
+	object, name, _ := strings.Cut(field.FullName, ".")
+	data.Id = types.StringValue(field.FullName)
+	data.Object = types.StringValue(object)
+	data.ApiName = types.StringValue(name)
+	data.Label = types.StringValue(field.Label)
+	data.Description = metadataString(field.Description)
+	data.InlineHelpText = metadataString(field.InlineHelpText)
+
+	fieldType := field.Type
+	priorTreatBlanksAs := data.FormulaTreatBlanksAs
+	data.Formula = types.StringNull()
+	data.FormulaReturnType = types.StringNull()
+	data.FormulaTreatBlanksAs = types.StringNull()
+	if field.Formula != "" {
+		fieldType = "Formula"
+		data.Formula = types.StringValue(field.Formula)
+		data.FormulaReturnType = types.StringValue(field.Type)
+		data.FormulaTreatBlanksAs = metadataDefault(priorTreatBlanksAs, field.FormulaTreatBlanksAs, "BlankAsZero")
+	}
+	data.Type = types.StringValue(fieldType)
+	allowed := customFieldTypeAttributes[fieldType]
+	isAllowed := func(name string) bool {
+		return contains(allowed.required, name) || contains(allowed.optional, name)
+	}
+
+	if fieldType == "Checkbox" {
+		data.DefaultValue = metadataDefault(data.DefaultValue, field.DefaultValue, "false")
+	} else {
+		data.DefaultValue = metadataString(field.DefaultValue)
+	}
+	data.Required = types.BoolValue(isAllowed("required") && field.Required != nil && *field.Required)
+	data.Unique = types.BoolValue(isAllowed("unique") && field.Unique != nil && *field.Unique)
+	data.ExternalId = types.BoolValue(isAllowed("external_id") && field.ExternalId != nil && *field.ExternalId)
+
+	data.Length = types.Int64Null()
+	if isAllowed("length") {
+		data.Length = types.Int64PointerValue(field.Length)
+	}
+	data.Precision = types.Int64Null()
+	data.Scale = types.Int64Null()
+	if isAllowed("precision") {
+		data.Precision = types.Int64PointerValue(field.Precision)
+		data.Scale = types.Int64PointerValue(field.Scale)
+	}
+
+	data.ReferenceTo = metadataString(field.ReferenceTo)
+	data.RelationshipName = metadataString(field.RelationshipName)
+	data.RelationshipLabel = metadataString(field.RelationshipLabel)
+	if fieldType == "Lookup" {
+		data.DeleteConstraint = metadataDefault(data.DeleteConstraint, field.DeleteConstraint, "SetNull")
+	} else {
+		data.DeleteConstraint = types.StringNull()
+	}
+	data.SummaryForeignKey = metadataString(field.SummaryForeignKey)
+	data.SummaryOperation = metadataString(field.SummaryOperation)
+	data.SummarizedField = metadataString(field.SummarizedField)
+
+	data.ValueSet = flattenValueSet(data.ValueSet, field.ValueSet)
+}
+
+// flattenValueSet keeps the optional settings null when they were before and still have their defaults
+func flattenValueSet(prior *customFieldValueSetModel, valueSet *metadata.ValueSet) *customFieldValueSetModel {
+	if valueSet == nil {
+		return nil
+	}
+	if prior == nil {
+		prior = &customFieldValueSetModel{}
+	}
+	data := &customFieldValueSetModel{
+		GlobalValueSet: metadataString(valueSet.ValueSetName),
+		Restricted:     metadataBoolDefault(prior.Restricted, valueSet.Restricted != nil && *valueSet.Restricted, valueSet.ValueSetName != ""),
+		Sorted:         types.BoolNull(),
+	}
+	if valueSet.ValueSetDefinition == nil {
+		return data
+	}
+	data.Sorted = metadataBoolDefault(prior.Sorted, valueSet.ValueSetDefinition.Sorted, false)
+	priorValues := make(map[string]picklistValueModel)
+	for _, v := range prior.Values {
+		priorValues[v.Value.ValueString()] = v
+	}
+	data.Values = []picklistValueModel{}
+	for _, v := range valueSet.ValueSetDefinition.Values {
+		if !v.Active() {
+			continue
+		}
+		priorValue, ok := priorValues[v.FullName]
+		if !ok {
+			priorValue = picklistValueModel{Label: types.StringNull(), Default: types.BoolNull()}
+		}
+		data.Values = append(data.Values, picklistValueModel{
+			Value:   types.StringValue(v.FullName),
+			Label:   metadataDefault(priorValue.Label, v.Label, v.FullName),
+			Default: metadataBoolDefault(priorValue.Default, v.Default, false),
+		})
+	}
+	return data
+}
+
+func (r *customFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data customFieldResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field := expandCustomField(data)
+	if err := r.metadata.Create(ctx, field); err != nil {
+		resp.Diagnostics.AddError("Error Creating Custom Field", err.Error())
+		return
+	}
+	data.Id = types.StringValue(field.FullName)
+	resp.Diagnostics.Append(r.readRelationshipLabel(ctx, &data)...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// readRelationshipLabel reads back the relationship label Salesforce picks when it isn't configured
+func (r *customFieldResource) readRelationshipLabel(ctx context.Context, data *customFieldResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !data.RelationshipLabel.IsUnknown() {
+		return diags
+	}
+	data.RelationshipLabel = types.StringNull()
+	field, _, err := metadata.Read[metadata.CustomField](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		diags.AddWarning("Error Reading Custom Field", fmt.Sprintf("Unable to read the relationship label of %s, it will be read on the next refresh: %v", data.Id.ValueString(), err))
+		return diags
+	}
+	data.RelationshipLabel = metadataString(field.RelationshipLabel)
+	return diags
+}
+
+func (r *customFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data customFieldResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, found, err := metadata.Read[metadata.CustomField](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Custom Field", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenCustomField(&data, field)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data customFieldResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, found, err := metadata.Read[metadata.CustomField](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Custom Field", err.Error())
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error Updating Custom Field", data.Id.ValueString()+" no longer exists.")
+		return
+	}
+	field := expandCustomField(data)
+	keepUnmanagedSettings(&field, current)
+	if err := r.metadata.Update(ctx, field); err != nil {
+		resp.Diagnostics.AddError("Error Updating Custom Field", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(r.readRelationshipLabel(ctx, &data)...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data customFieldResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "CustomField", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Custom Field", err.Error())
+		return
+	}
+}
+
+func (r *customFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	object, name, ok := strings.Cut(req.ID, ".")
+	if !ok || object == "" || checkCustomName(name, "__c") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Object.Field__c. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object"), object)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_name"), name)...)
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func TestExpandCustomFieldFormula(t *testing.T) {
+	t.Parallel()
+
+	data := customFieldResourceModel{
+		Object:            types.StringValue("Widget__c"),
+		ApiName:           types.StringValue("Total__c"),
+		Label:             types.StringValue("Total"),
+		Type:              types.StringValue("Formula"),
+		Formula:           types.StringValue("Price__c * Quantity__c"),
+		FormulaReturnType: types.StringValue("Currency"),
+		Precision:         types.Int64Value(18),
+		Scale:             types.Int64Value(2),
+		Required:          types.BoolValue(false),
+		Unique:            types.BoolValue(false),
+		ExternalId:        types.BoolValue(false),
+	}
+	field := expandCustomField(data)
+	if field.FullName != "Widget__c.Total__c" || field.Type != "Currency" || field.Formula != "Price__c * Quantity__c" {
+		t.Errorf("unexpected field %#v", field)
+	}
+	// required isn't valid for formulas and mustn't be sent
+	if field.Required != nil || field.Unique != nil || field.ExternalId != nil {
+		t.Errorf("unexpected required, unique or external id on a formula")
+	}
+
+	var read customFieldResourceModel
+	flattenCustomField(&read, field)
+	if read.Type.ValueString() != "Formula" || read.FormulaReturnType.ValueString() != "Currency" || read.Precision.ValueInt64() != 18 {
+		t.Errorf("unexpected flattened formula %#v", read)
+	}
+	if !read.FormulaTreatBlanksAs.IsNull() {
+		t.Errorf("expected the default treat blanks as to stay null, got %s", read.FormulaTreatBlanksAs)
+	}
+}
+
+func TestFlattenCustomFieldDefaults(t *testing.T) {
+	t.Parallel()
+
+	data := customFieldResourceModel{
+		DefaultValue: types.StringNull(),
+		ValueSet: &customFieldValueSetModel{
+			Restricted: types.BoolValue(true),
+			Sorted:     types.BoolNull(),
+			Values: []picklistValueModel{
+				{Value: types.StringValue("Red"), Label: types.StringNull(), Default: types.BoolNull()},
+				{Value: types.StringValue("Dark_Blue"), Label: types.StringValue("Dark Blue"), Default: types.BoolValue(true)},
+			},
+		},
+	}
+	field := metadata.CustomField{
+		FullName: "Widget__c.Color__c",
+		Label:    "Color",
+		Type:     "Picklist",
+		ValueSet: &metadata.ValueSet{
+			Restricted: boolPointer(true),
+			ValueSetDefinition: &metadata.ValueSetDefinition{
+				Values: []metadata.CustomValue{
+					{FullName: "Red", Label: "Red"},
+					{FullName: "Dark_Blue", Label: "Dark Blue", Default: true},
+					{FullName: "Green", Label: "Green", IsActive: boolPointer(false)},
+				},
+			},
+		},
+	}
+	flattenCustomField(&data, field)
+	values := data.ValueSet.Values
+	if len(values) != 2 {
+		t.Fatalf("expected the inactive value to be left out, got %#v", values)
+	}
+	if !values[0].Label.IsNull() || !values[0].Default.IsNull() {
+		t.Errorf("expected the unset label and default to stay null, got %#v", values[0])
+	}
+	if values[1].Label.ValueString() != "Dark Blue" || !values[1].Default.ValueBool() {
+		t.Errorf("unexpected value %#v", values[1])
+	}
+	if !data.ValueSet.Sorted.IsNull() || !data.ValueSet.Restricted.ValueBool() {
+		t.Errorf("unexpected value set %#v", data.ValueSet)
+	}
+
+	checkbox := customFieldResourceModel{DefaultValue: types.StringNull()}
+	flattenCustomField(&checkbox, metadata.CustomField{FullName: "Widget__c.Active__c", Type: "Checkbox", DefaultValue: "false"})
+	if !checkbox.DefaultValue.IsNull() {
+		t.Errorf("expected the checkbox default to stay null, got %s", checkbox.DefaultValue)
+	}
+}
+
+func TestCustomFieldTypeGroup(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		from, to string
+		replace  bool
+	}{
+		{"Text", "Picklist", false},
+		{"Number", "Currency", false},
+		{"Lookup", "MasterDetail", false},
+		{"Text", "Checkbox", true},
+		{"Number", "Formula", true},
+		{"Lookup", "Text", true},
+		{"Summary", "Number", true},
+	}
+	for _, c := range cases {
+		if replace := customFieldTypeGroup(c.from) != customFieldTypeGroup(c.to); replace != c.replace {
+			t.Errorf("%s to %s: got replace %v, want %v", c.from, c.to, replace, c.replace)
+		}
+	}
+}
+
+func TestKeepUnmanagedSettings(t *testing.T) {
+	t.Parallel()
+
+	current := metadata.CustomField{
+		FullName:                "Widget__c.Owner__c",
+		Label:                   "Owner",
+		Type:                    "MasterDetail",
+		LookupFilter:            &metadata.RawElement{InnerXML: "<active>true</active>"},
+		SecurityClassification:  "Confidential",
+		TrackHistory:            boolPointer(true),
+		WriteRequiresMasterRead: boolPointer(true),
+	}
+
+	field := metadata.CustomField{FullName: "Widget__c.Owner__c", Label: "Account Owner", Type: "MasterDetail"}
+	keepUnmanagedSettings(&field, current)
+	if field.Label != "Account Owner" || field.LookupFilter == nil || field.SecurityClassification != "Confidential" || field.TrackHistory == nil || !*field.TrackHistory {
+		t.Errorf("expected the managed label and the unmanaged settings, got %#v", field)
+	}
+	if field.WriteRequiresMasterRead == nil {
+		t.Errorf("expected the master-detail settings to be kept")
+	}
+
+	// master-detail settings aren't valid once converted to a lookup
+	field = metadata.CustomField{FullName: "Widget__c.Owner__c", Label: "Owner", Type: "Lookup"}
+	keepUnmanagedSettings(&field, current)
+	if field.WriteRequiresMasterRead != nil || field.TrackHistory == nil {
+		t.Errorf("expected only the settings of every type to be kept, got %#v", field)
+	}
+}
+
+func TestAccResourceCustomField_basic(t *testing.T) {
+	t.Parallel()
+
+	object := fmt.Sprintf("tf_test_%s__c", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomField_basic(object, 40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_field.text", "id", object+".Code__c"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.text", "length", "40"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.text", "external_id", "true"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.checkbox", "required", "false"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.picklist", "value_set.values.#", "2"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.formula", "formula_return_type", "Currency"),
+					resource.TestCheckResourceAttrSet("salesforce_custom_field.lookup", "relationship_label"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_custom_field.text",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "salesforce_custom_field.formula",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "salesforce_custom_field.lookup",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_constraint"},
+			},
+			{
+				Config: testAccResourceCustomField_basic(object, 80),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_field.text", "length", "80"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCustomField_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceCustomField_invalid(`type = "Text"`),
+				ExpectError: regexp.MustCompile("length must be set for a Text field"),
+			},
+			{
+				Config:      testAccResourceCustomField_invalid(`type = "Checkbox"` + "\n" + `length = 10`),
+				ExpectError: regexp.MustCompile("length can't be set for a Checkbox field"),
+			},
+			{
+				Config:      testAccResourceCustomField_invalid(`type = "Summary"` + "\n" + `summary_foreign_key = "Child__c.Parent__c"` + "\n" + `summary_operation = "sum"`),
+				ExpectError: regexp.MustCompile("summarized_field must be set for a sum"),
+			},
+		},
+	})
+}
+
+func testAccResourceCustomField_basic(object string, length int) string {
+	return fmt.Sprintf(`
+resource "salesforce_custom_object" "test" {
+  api_name     = "%s"
+  label        = "Widget"
+  plural_label = "Widgets"
+  name_field = {
+    label = "Widget Name"
+  }
+}
+
+resource "salesforce_custom_field" "text" {
+  object      = salesforce_custom_object.test.api_name
+  api_name    = "Code__c"
+  label       = "Code"
+  type        = "Text"
+  length      = %d
+  unique      = true
+  external_id = true
+}
+
+resource "salesforce_custom_field" "checkbox" {
+  object   = salesforce_custom_object.test.api_name
+  api_name = "Active__c"
+  label    = "Active"
+  type     = "Checkbox"
+}
+
+resource "salesforce_custom_field" "price" {
+  object    = salesforce_custom_object.test.api_name
+  api_name  = "Price__c"
+  label     = "Price"
+  type      = "Currency"
+  precision = 16
+  scale     = 2
+}
+
+resource "salesforce_custom_field" "picklist" {
+  object   = salesforce_custom_object.test.api_name
+  api_name = "Color__c"
+  label    = "Color"
+  type     = "Picklist"
+  value_set = {
+    restricted = true
+    values = [
+      { value = "Red", default = true },
+      { value = "Dark_Blue", label = "Dark Blue" },
+    ]
+  }
+}
+
+resource "salesforce_custom_field" "formula" {
+  object              = salesforce_custom_object.test.api_name
+  api_name            = "Price_With_Tax__c"
+  label               = "Price With Tax"
+  type                = "Formula"
+  formula             = "${salesforce_custom_field.price.api_name} * 1.2"
+  formula_return_type = "Currency"
+  precision           = 18
+  scale               = 2
+}
+
+resource "salesforce_custom_field" "lookup" {
+  object            = salesforce_custom_object.test.api_name
+  api_name          = "Account__c"
+  label             = "Account"
+  type              = "Lookup"
+  reference_to      = "Account"
+  relationship_name = "%s"
+  delete_constraint = "SetNull"
+}
+`, object, length, object[:len(object)-3])
+}
+
+func testAccResourceCustomField_invalid(attributes string) string {
+	return fmt.Sprintf(`
+resource "salesforce_custom_field" "test" {
+  object   = "Widget__c"
+  api_name = "Test__c"
+  label    = "Test"
+  %s
+}
+`, attributes)
+}
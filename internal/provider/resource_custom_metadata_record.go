@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+// customMetadataAttributes maps the fields named by Metadata API errors to attributes
+var customMetadataAttributes = map[string]path.Path{
+	"fullName": path.Root("name"),
+	"label":    path.Root("label"),
+	"values":   path.Root("values"),
+}
+
+// customMetadataXSDTypes maps describe field types to the XML schema types of custom metadata values,
+// the other types are strings
+var customMetadataXSDTypes = map[string]string{
+	"boolean":  "xsd:boolean",
+	"currency": "xsd:double",
+	"date":     "xsd:date",
+	"datetime": "xsd:dateTime",
+	"double":   "xsd:double",
+	"int":      "xsd:int",
+	"percent":  "xsd:double",
+}
+
+type customMetadataRecordResource struct {
+	client   *force.ForceApi
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                = &customMetadataRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &customMetadataRecordResource{}
+	_ resource.ResourceWithImportState = &customMetadataRecordResource{}
+)
+
+func (r *customMetadataRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_custom_metadata_record"
+}
+
+func (r *customMetadataRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Custom Metadata Record Resource for the Salesforce Provider, a record of a custom metadata type deployed through the Metadata API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the full name of the record in the format Type.RecordName, without the __mdt suffix of the type.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "API name of the custom metadata type, ending in __mdt. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{suffix: "__mdt"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the record within the type. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the record.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"protected": schema.BoolAttribute{
+				Description: "Whether the record is hidden from subscribers when the type is in a managed package. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"values": schema.MapAttribute{
+				Description: "Map of field API names (ending in __c) to values. Values are given as strings and converted according to the field's type: numbers, true/false for checkboxes, YYYY-MM-DD for dates and RFC 3339 for datetimes, picklist values are validated against the org. The record is deployed as a whole, so fields that aren't in the map have no value, except checkboxes which are false.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type customMetadataRecordResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Type      types.String `tfsdk:"type"`
+	Name      types.String `tfsdk:"name"`
+	Label     types.String `tfsdk:"label"`
+	Protected types.Bool   `tfsdk:"protected"`
+	Values    types.Map    `tfsdk:"values"`
+}
+
+// customMetadataValues converts the configured values into typed custom metadata values according to the
+// describe of the type, unknown values are skipped so this can also validate plans
+func customMetadataValues(desc *force.SObjectDescription, values types.Map) ([]metadata.CustomMetadataValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	describeFields := sobjectFieldsByName(desc)
+
+	var out []metadata.CustomMetadataValue
+	for name, element := range values.Elements() {
+		field, ok := describeFields[strings.ToLower(name)]
+		if !ok || !field.Custom {
+			diags.AddAttributeError(
+				path.Root("values").AtMapKey(name),
+				"Invalid custom metadata field",
+				fmt.Sprintf("%s has no custom field named %s", desc.Name, name),
+			)
+			continue
+		}
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			continue
+		}
+		v := metadata.CustomMetadataValue{Field: field.Name, Type: "xsd:string"}
+		if xsdType, ok := customMetadataXSDTypes[field.Type]; ok {
+			v.Type = xsdType
+		}
+		if !value.IsNull() {
+			if _, err := coerceSObjectFieldString(field, value.ValueString()); err != nil {
+				diags.AddAttributeError(path.Root("values").AtMapKey(name), "Invalid custom metadata value", err.Error())
+				continue
+			}
+			s := value.ValueString()
+			v.Value = &s
+		}
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out, diags
+}
+
+// flattenCustomMetadataValues reads the values of a record keeping the representation of the prior values,
+// checkboxes that are false and not in prior are left out as they always have a value
+func flattenCustomMetadataValues(prior types.Map, values []metadata.CustomMetadataValue) types.Map {
+	// field names are case insensitive, keep the names as configured
+	priorElements := make(map[string]attr.Value)
+	names := make(map[string]string)
+	for name, element := range prior.Elements() {
+		priorElements[strings.ToLower(name)] = element
+		names[strings.ToLower(name)] = name
+	}
+
+	elements := make(map[string]attr.Value)
+	for _, v := range values {
+		if v.Value == nil {
+			continue
+		}
+		key := strings.ToLower(v.Field)
+		like, inPrior := priorElements[key]
+		if !inPrior {
+			like = types.StringNull()
+		}
+		name, ok := names[key]
+		if !ok {
+			name = v.Field
+		}
+
+		var raw interface{} = *v.Value
+		field := &force.SObjectField{Name: v.Field, Type: "string"}
+		switch v.Type {
+		case "xsd:boolean":
+			if b, err := strconv.ParseBool(*v.Value); err == nil {
+				if !b && !inPrior {
+					continue
+				}
+				raw = b
+			}
+		case "xsd:double", "xsd:int":
+			if f, err := strconv.ParseFloat(*v.Value, 64); err == nil {
+				raw = f
+			}
+		case "xsd:dateTime":
+			field.Type = "datetime"
+		}
+		element, err := sobjectValueFromInterface(raw, like, field)
+		if err != nil {
+			element = types.StringValue(*v.Value)
+		}
+		elements[name] = element
+	}
+	if len(elements) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func (r *customMetadataRecordResource) expandCustomMetadata(data customMetadataRecordResourceModel) (metadata.CustomMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	record := metadata.CustomMetadata{
+		FullName:  customMetadataFullName(data.Type.ValueString(), data.Name.ValueString()),
+		Label:     data.Label.ValueString(),
+		Protected: data.Protected.ValueBool(),
+	}
+	if data.Values.IsNull() || len(data.Values.Elements()) == 0 {
+		return record, diags
+	}
+	desc, err := describeSObject(r.client, data.Type.ValueString())
+	if err != nil {
+		diags.AddError("Error Describing Custom Metadata Type", err.Error())
+		return record, diags
+	}
+	record.Values, diags = customMetadataValues(desc, data.Values)
+	return record, diags
+}
+
+// customMetadataFullName returns the full name of a record, the type is named without its __mdt suffix
+func customMetadataFullName(typeName, name string) string {
+	return strings.TrimSuffix(typeName, "__mdt") + "." + name
+}
+
+func (r *customMetadataRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data customMetadataRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Values.IsUnknown() {
+		return
+	}
+	_, diags := r.expandCustomMetadata(data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customMetadataRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data customMetadataRecordResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, diags := r.expandCustomMetadata(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.metadata.Create(ctx, record); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating Custom Metadata Record", err, customMetadataAttributes)...)
+		return
+	}
+	data.Id = types.StringValue(record.FullName)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customMetadataRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data customMetadataRecordResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, found, err := metadata.Read[metadata.CustomMetadata](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Custom Metadata Record", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	typeName, name, _ := strings.Cut(record.FullName, ".")
+	data.Id = types.StringValue(record.FullName)
+	data.Type = types.StringValue(typeName + "__mdt")
+	data.Name = types.StringValue(name)
+	data.Label = types.StringValue(record.Label)
+	data.Protected = types.BoolValue(record.Protected)
+	data.Values = flattenCustomMetadataValues(data.Values, record.Values)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customMetadataRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data customMetadataRecordResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, diags := r.expandCustomMetadata(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.metadata.Update(ctx, record); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating Custom Metadata Record", err, customMetadataAttributes)...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customMetadataRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data customMetadataRecordResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "CustomMetadata", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Custom Metadata Record", err.Error())
+		return
+	}
+}
+
+func (r *customMetadataRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	typeName, name, ok := strings.Cut(req.ID, ".")
+	if !ok || checkCustomName(strings.TrimSuffix(typeName, "__mdt"), "") != nil || checkCustomName(name, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Type.RecordName. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), customMetadataFullName(typeName, name))...)
+}
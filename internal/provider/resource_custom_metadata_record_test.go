@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+func testCustomMetadataString(s string) *string {
+	return &s
+}
+
+func TestCustomMetadataValues(t *testing.T) {
+	t.Parallel()
+
+	desc := &force.SObjectDescription{
+		Name: "Feature_Toggle__mdt",
+		Fields: []*force.SObjectField{
+			{Name: "DeveloperName", Type: "string"},
+			{Name: "Enabled__c", Type: "boolean", Custom: true},
+			{Name: "Rollout_Percent__c", Type: "percent", Custom: true},
+			{Name: "Owner_Team__c", Type: "string", Custom: true},
+		},
+	}
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"enabled__c":         types.StringValue("true"),
+		"Rollout_Percent__c": types.StringValue("25"),
+		"Owner_Team__c":      types.StringNull(),
+	})
+	got, diags := customMetadataValues(desc, values)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors %v", diags)
+	}
+	want := []metadata.CustomMetadataValue{
+		{Field: "Enabled__c", Type: "xsd:boolean", Value: testCustomMetadataString("true")},
+		{Field: "Owner_Team__c", Type: "xsd:string"},
+		{Field: "Rollout_Percent__c", Type: "xsd:double", Value: testCustomMetadataString("25")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	invalid := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Enabled__c":    types.StringValue("yes"),
+		"DeveloperName": types.StringValue("Checkout"),
+		"Missing__c":    types.StringValue("x"),
+	})
+	if _, diags := customMetadataValues(desc, invalid); diags.ErrorsCount() != 3 {
+		t.Errorf("expected 3 errors, got %v", diags)
+	}
+}
+
+func TestFlattenCustomMetadataValues(t *testing.T) {
+	t.Parallel()
+
+	values := []metadata.CustomMetadataValue{
+		{Field: "Enabled__c", Type: "xsd:boolean", Value: testCustomMetadataString("false")},
+		{Field: "Rollout_Percent__c", Type: "xsd:double", Value: testCustomMetadataString("25.0")},
+		{Field: "Owner_Team__c"},
+		{Field: "Beta__c", Type: "xsd:boolean", Value: testCustomMetadataString("false")},
+		{Field: "Notes__c", Type: "xsd:string", Value: testCustomMetadataString("set in Setup")},
+	}
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"enabled__c":         types.StringValue("false"),
+		"Rollout_Percent__c": types.StringValue("25"),
+	})
+	got := flattenCustomMetadataValues(prior, values)
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"enabled__c":         types.StringValue("false"),
+		"Rollout_Percent__c": types.StringValue("25"),
+		"Notes__c":           types.StringValue("set in Setup"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	// only unchecked checkboxes
+	if got := flattenCustomMetadataValues(types.MapNull(types.StringType), values[3:4]); !got.IsNull() {
+		t.Errorf("expected null, got %s", got)
+	}
+}
+
+func TestAccResourceCustomMetadataRecord_basic(t *testing.T) {
+	t.Parallel()
+
+	typeName := os.Getenv("SALESFORCE_CUSTOM_METADATA_TYPE")
+	if typeName == "" {
+		t.Skip("SALESFORCE_CUSTOM_METADATA_TYPE must name a custom metadata type with an Enabled__c checkbox and a Rollout_Percent__c percent field to run this test")
+	}
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomMetadataRecord_basic(typeName, name, "true", "25"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_metadata_record.test", "id", customMetadataFullName(typeName, name)),
+					resource.TestCheckResourceAttr("salesforce_custom_metadata_record.test", "protected", "false"),
+					resource.TestCheckResourceAttr("salesforce_custom_metadata_record.test", "values.Rollout_Percent__c", "25"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_custom_metadata_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the import reads 25 back as 25.0
+				ImportStateVerifyIgnore: []string{"values.Rollout_Percent__c"},
+			},
+			{
+				Config: testAccResourceCustomMetadataRecord_basic(typeName, name, "false", "100"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_metadata_record.test", "values.Enabled__c", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCustomMetadataRecord_basic(typeName, name, enabled, percent string) string {
+	return fmt.Sprintf(`
+resource "salesforce_custom_metadata_record" "test" {
+  type  = "%s"
+  name  = "%s"
+  label = "Terraform Test"
+  values = {
+    Enabled__c         = "%s"
+    Rollout_Percent__c = "%s"
+  }
+}
+`, typeName, name, enabled, percent)
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+var customObjectDefaults = resourceDefaults{
+	defaults: map[string]attr.Value{
+		path.Root("sharing_model").String():             types.StringValue("ReadWrite"),
+		path.Root("deployment_status").String():         types.StringValue("Deployed"),
+		path.Root("name_field").AtName("type").String(): types.StringValue("Text"),
+	},
+}
+
+// an auto number format needs a {0} placeholder for the number, zero padded to the number of zeros
+var autoNumberFormatRegexp = regexp.MustCompile(`\{0+\}`)
+
+type customObjectResource struct {
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                   = &customObjectResource{}
+	_ resource.ResourceWithValidateConfig = &customObjectResource{}
+	_ resource.ResourceWithModifyPlan     = &customObjectResource{}
+	_ resource.ResourceWithImportState    = &customObjectResource{}
+)
+
+func (r *customObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_custom_object"
+}
+
+func (r *customObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Custom Object Resource for the Salesforce Provider, managed through the Metadata API. Fields are managed separately. A deleted object is kept in the recycle bin for 15 days, during which its API name can't be reused.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the API name of the object.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_name": schema.StringAttribute{
+				Description: "API name of the object, ending with __c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{suffix: "__c"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the object in the user interface.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"plural_label": schema.StringAttribute{
+				Description: "Plural label of the object, used for tabs and related lists.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the object.",
+				Optional:    true,
+			},
+			"name_field": schema.SingleNestedAttribute{
+				Description: "The name field of the object, shown as the record name.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"label": schema.StringAttribute{
+						Description: "Label of the name field.",
+						Required:    true,
+						Validators: []validator.String{
+							notEmptyString{},
+						},
+					},
+					"type": schema.StringAttribute{
+						Description: "Type of the name field, Text or AutoNumber. Defaults to Text.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringInSlice{slice: []string{"Text", "AutoNumber"}},
+						},
+						PlanModifiers: []planmodifier.String{
+							customObjectDefaults,
+						},
+					},
+					"display_format": schema.StringAttribute{
+						Description: "Format of an AutoNumber name field, with the number as a zero padded placeholder, such as W-{0000}. Required with the AutoNumber type.",
+						Optional:    true,
+					},
+					"starting_number": schema.Int64Attribute{
+						Description: "Number of the next record of an AutoNumber name field. It's only sent to Salesforce and isn't read back, changing it restarts the numbering.",
+						Optional:    true,
+					},
+				},
+			},
+			"sharing_model": schema.StringAttribute{
+				Description: "Organization wide default for the records, one of Private, Read, ReadWrite or ControlledByParent. ControlledByParent requires a master-detail field. Defaults to ReadWrite.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"Private", "Read", "ReadWrite", "ControlledByParent"}},
+				},
+				PlanModifiers: []planmodifier.String{
+					customObjectDefaults,
+				},
+			},
+			"deployment_status": schema.StringAttribute{
+				Description: "Deployed, or InDevelopment to hide the object from users who don't have the Customize Application permission. Defaults to Deployed.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: []string{"Deployed", "InDevelopment"}},
+				},
+				PlanModifiers: []planmodifier.String{
+					customObjectDefaults,
+				},
+			},
+			"enable_history": schema.BoolAttribute{
+				Description: "Enables field history tracking for the object. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"enable_activities": schema.BoolAttribute{
+				Description: "Allows activities such as tasks and events on the records. Salesforce doesn't allow disabling it once enabled. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"enable_reports": schema.BoolAttribute{
+				Description: "Allows reports on the records. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"enable_search": schema.BoolAttribute{
+				Description: "Allows the records to be found by search. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+		},
+	}
+}
+
+type customObjectResourceModel struct {
+	Id               types.String                `tfsdk:"id"`
+	ApiName          types.String                `tfsdk:"api_name"`
+	Label            types.String                `tfsdk:"label"`
+	PluralLabel      types.String                `tfsdk:"plural_label"`
+	Description      types.String                `tfsdk:"description"`
+	NameField        *customObjectNameFieldModel `tfsdk:"name_field"`
+	SharingModel     types.String                `tfsdk:"sharing_model"`
+	DeploymentStatus types.String                `tfsdk:"deployment_status"`
+	EnableHistory    types.Bool                  `tfsdk:"enable_history"`
+	EnableActivities types.Bool                  `tfsdk:"enable_activities"`
+	EnableReports    types.Bool                  `tfsdk:"enable_reports"`
+	EnableSearch     types.Bool                  `tfsdk:"enable_search"`
+}
+
+type customObjectNameFieldModel struct {
+	Label          types.String `tfsdk:"label"`
+	Type           types.String `tfsdk:"type"`
+	DisplayFormat  types.String `tfsdk:"display_format"`
+	StartingNumber types.Int64  `tfsdk:"starting_number"`
+}
+
+// expandCustomObject sets the managed settings on object, leaving the others as read
+func expandCustomObject(data customObjectResourceModel, object *metadata.CustomObject) {
+	object.FullName = data.ApiName.ValueString()
+	object.Label = data.Label.ValueString()
+	object.PluralLabel = data.PluralLabel.ValueString()
+	object.Description = data.Description.ValueString()
+	object.SharingModel = data.SharingModel.ValueString()
+	object.DeploymentStatus = data.DeploymentStatus.ValueString()
+	object.EnableHistory = boolPointer(data.EnableHistory.ValueBool())
+	object.EnableActivities = boolPointer(data.EnableActivities.ValueBool())
+	object.EnableReports = boolPointer(data.EnableReports.ValueBool())
+	object.EnableSearch = boolPointer(data.EnableSearch.ValueBool())
+	object.NameField = &metadata.CustomField{
+		Label:          data.NameField.Label.ValueString(),
+		Type:           data.NameField.Type.ValueString(),
+		DisplayFormat:  data.NameField.DisplayFormat.ValueString(),
+		StartingNumber: data.NameField.StartingNumber.ValueInt64Pointer(),
+	}
+}
+
+func flattenCustomObject(data *customObjectResourceModel, object metadata.CustomObject) {
+	data.Id = types.StringValue(object.FullName)
+	data.ApiName = types.StringValue(object.FullName)
+	data.Label = types.StringValue(object.Label)
+	data.PluralLabel = types.StringValue(object.PluralLabel)
+	data.Description = metadataString(object.Description)
+	data.SharingModel = types.StringValue(object.SharingModel)
+	data.DeploymentStatus = types.StringValue(object.DeploymentStatus)
+	data.EnableHistory = types.BoolValue(object.EnableHistory != nil && *object.EnableHistory)
+	data.EnableActivities = types.BoolValue(object.EnableActivities != nil && *object.EnableActivities)
+	data.EnableReports = types.BoolValue(object.EnableReports != nil && *object.EnableReports)
+	data.EnableSearch = types.BoolValue(object.EnableSearch != nil && *object.EnableSearch)
+
+	// the starting number isn't read back, keep the configured one
+	startingNumber := types.Int64Null()
+	if data.NameField != nil {
+		startingNumber = data.NameField.StartingNumber
+	}
+	data.NameField = &customObjectNameFieldModel{
+		StartingNumber: startingNumber,
+	}
+	if object.NameField != nil {
+		data.NameField.Label = types.StringValue(object.NameField.Label)
+		data.NameField.Type = types.StringValue(object.NameField.Type)
+		data.NameField.DisplayFormat = metadataString(object.NameField.DisplayFormat)
+	}
+}
+
+func (r *customObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data customObjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.NameField == nil || data.NameField.Type.IsUnknown() {
+		return
+	}
+
+	nameField := data.NameField
+	if nameField.Type.ValueString() == "AutoNumber" {
+		if nameField.DisplayFormat.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_field").AtName("display_format"),
+				"Missing display format",
+				"display_format must be set for an AutoNumber name field.",
+			)
+		} else if !nameField.DisplayFormat.IsUnknown() && !autoNumberFormatRegexp.MatchString(nameField.DisplayFormat.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_field").AtName("display_format"),
+				"Invalid display format",
+				"display_format must contain a placeholder for the number, such as {0000}.",
+			)
+		}
+		return
+	}
+	for _, field := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"display_format", nameField.DisplayFormat},
+		{"starting_number", nameField.StartingNumber},
+	} {
+		if !field.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_field").AtName(field.name),
+				"Invalid Attribute Combination",
+				field.name+" can only be set for an AutoNumber name field.",
+			)
+		}
+	}
+}
+
+func (r *customObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state customObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.EnableActivities.ValueBool() && !plan.EnableActivities.IsUnknown() && !plan.EnableActivities.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enable_activities"),
+			"Activities can't be disabled",
+			"Salesforce doesn't allow disabling activities on an object once they are enabled.",
+		)
+	}
+}
+
+func (r *customObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data customObjectResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// new objects are enterprise application objects in Setup, with bulk API, sharing and streaming enabled
+	object := metadata.CustomObject{
+		EnableBulkApi:      boolPointer(true),
+		EnableSharing:      boolPointer(true),
+		EnableStreamingApi: boolPointer(true),
+	}
+	expandCustomObject(data, &object)
+	if err := r.metadata.Create(ctx, object); err != nil {
+		resp.Diagnostics.AddError("Error Creating Custom Object", err.Error())
+		return
+	}
+	data.Id = data.ApiName
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data customObjectResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, found, err := metadata.Read[metadata.CustomObject](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Custom Object", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenCustomObject(&data, object)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data customObjectResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an update replaces the object settings, so start from the current ones to send back those that
+	// aren't managed, child components such as fields aren't part of the settings and are left alone
+	object, found, err := metadata.Read[metadata.CustomObject](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Custom Object", err.Error())
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error Updating Custom Object", data.Id.ValueString()+" no longer exists.")
+		return
+	}
+	expandCustomObject(data, &object)
+	if err := r.metadata.Update(ctx, object); err != nil {
+		resp.Diagnostics.AddError("Error Updating Custom Object", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data customObjectResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "CustomObject", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Custom Object", err.Error())
+		return
+	}
+}
+
+func (r *customObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if err := checkCustomName(req.ID, "__c"); err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected the API name of a custom object. "+err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_name"), req.ID)...)
+}
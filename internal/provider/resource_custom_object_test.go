@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceCustomObject_basic(t *testing.T) {
+	t.Parallel()
+
+	apiName := fmt.Sprintf("tf_test_%s__c", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomObject_basic(apiName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "id", apiName),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "name_field.type", "Text"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "sharing_model", "ReadWrite"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "deployment_status", "Deployed"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_reports", "false"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_custom_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceCustomObject_autoNumber(apiName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "label", "Gadget"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "name_field.type", "AutoNumber"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "name_field.display_format", "G-{0000}"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "sharing_model", "Private"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_history", "true"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_activities", "true"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_reports", "true"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_search", "true"),
+				),
+			},
+			{
+				ResourceName:            "salesforce_custom_object.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_field.starting_number"},
+			},
+			{
+				Config:      testAccResourceCustomObject_basic(apiName),
+				ExpectError: regexp.MustCompile("Activities can't be disabled"),
+			},
+		},
+	})
+}
+
+func TestAccResourceCustomObject_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceCustomObject_basic("Widget"),
+				ExpectError: regexp.MustCompile("must end with __c"),
+			},
+			{
+				Config:      testAccResourceCustomObject_missingFormat(),
+				ExpectError: regexp.MustCompile("display_format must be set for an AutoNumber name field"),
+			},
+		},
+	})
+}
+
+func testAccResourceCustomObject_basic(apiName string) string {
+	return fmt.Sprintf(`
+resource "salesforce_custom_object" "test" {
+  api_name     = "%s"
+  label        = "Widget"
+  plural_label = "Widgets"
+  name_field = {
+    label = "Widget Name"
+  }
+}
+`, apiName)
+}
+
+func testAccResourceCustomObject_autoNumber(apiName string) string {
+	return fmt.Sprintf(`
+resource "salesforce_custom_object" "test" {
+  api_name     = "%s"
+  label        = "Gadget"
+  plural_label = "Gadgets"
+  description  = "Managed by Terraform"
+  name_field = {
+    label           = "Gadget Number"
+    type            = "AutoNumber"
+    display_format  = "G-{0000}"
+    starting_number = 100
+  }
+  sharing_model     = "Private"
+  enable_history    = true
+  enable_activities = true
+  enable_reports    = true
+  enable_search     = true
+}
+`, apiName)
+}
+
+func testAccResourceCustomObject_missingFormat() string {
+	return `
+resource "salesforce_custom_object" "test" {
+  api_name     = "Widget__c"
+  label        = "Widget"
+  plural_label = "Widgets"
+  name_field = {
+    label = "Widget Number"
+    type  = "AutoNumber"
+  }
+}
+`
+}
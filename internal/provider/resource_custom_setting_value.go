@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type customSettingValueResource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ resource.Resource                   = &customSettingValueResource{}
+	_ resource.ResourceWithValidateConfig = &customSettingValueResource{}
+	_ resource.ResourceWithModifyPlan     = &customSettingValueResource{}
+	_ resource.ResourceWithImportState    = &customSettingValueResource{}
+)
+
+func (r *customSettingValueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_custom_setting_value"
+}
+
+func (r *customSettingValueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Custom Setting Value Resource for the Salesforce Provider, a row of a hierarchy custom setting for the org, a profile or a user, or a row of a list custom setting. Creating the resource updates the existing row for the owner or name, adopting it, instead of failing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the custom setting row.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"setting": schema.StringAttribute{
+				Description: "API name of the custom setting, ending in __c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{suffix: "__c"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"setup_owner_id": schema.StringAttribute{
+				Description: "ID of the org, profile or user the row of a hierarchy custom setting applies to. Exactly one of setup_owner_id or name must be set. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the row of a list custom setting. Exactly one of setup_owner_id or name must be set. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.MapAttribute{
+				Description: "Map of custom field API names (ending in __c) to values. Values are given as strings and converted according to the field's type: numbers, true/false for checkboxes, YYYY-MM-DD for dates and RFC 3339 for datetimes. Only fields present in config are read back, removing a field stops managing it without clearing its value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type customSettingValueResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Setting      types.String `tfsdk:"setting"`
+	SetupOwnerId types.String `tfsdk:"setup_owner_id"`
+	Name         types.String `tfsdk:"name"`
+	Values       types.Map    `tfsdk:"values"`
+}
+
+// key returns the field and value identifying the row of the setting
+func (data customSettingValueResourceModel) key() (string, string) {
+	if !data.SetupOwnerId.IsNull() {
+		return "SetupOwnerId", normalizeId(data.SetupOwnerId.ValueString())
+	}
+	return "Name", data.Name.ValueString()
+}
+
+func (r *customSettingValueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data customSettingValueResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SetupOwnerId.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+	if data.SetupOwnerId.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("setup_owner_id"), "Invalid Attribute Combination", "Exactly one of setup_owner_id or name must be set.")
+	}
+}
+
+// describeCustomSetting describes the setting, checking it is a custom setting
+func (r *customSettingValueResource) describeCustomSetting(setting string) (*force.SObjectDescription, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	desc, err := describeSObject(r.client, setting)
+	if err != nil {
+		diags.AddError("Error Describing Custom Setting", err.Error())
+		return nil, diags
+	}
+	if !desc.CustomSetting {
+		diags.AddAttributeError(path.Root("setting"), "Invalid custom setting", fmt.Sprintf("%s is not a custom setting.", setting))
+		return nil, diags
+	}
+	return desc, diags
+}
+
+// customSettingFieldValues converts the configured values into API values according to the describe of the
+// setting, unknown values are skipped so this can also validate plans
+func customSettingFieldValues(desc *force.SObjectDescription, values types.Map) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	describeFields := sobjectFieldsByName(desc)
+
+	out := make(map[string]interface{}, len(values.Elements()))
+	for name, element := range values.Elements() {
+		field, ok := describeFields[strings.ToLower(name)]
+		if !ok || !field.Custom {
+			diags.AddAttributeError(
+				path.Root("values").AtMapKey(name),
+				"Invalid custom setting field",
+				fmt.Sprintf("%s has no custom field named %s", desc.Name, name),
+			)
+			continue
+		}
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			continue
+		}
+		if value.IsNull() {
+			out[field.Name] = nil
+			continue
+		}
+		v, err := coerceSObjectFieldString(field, value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("values").AtMapKey(name), "Invalid custom setting value", err.Error())
+			continue
+		}
+		out[field.Name] = v
+	}
+	return out, diags
+}
+
+func (r *customSettingValueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data customSettingValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Setting.IsUnknown() || data.Values.IsUnknown() {
+		return
+	}
+	desc, diags := r.describeCustomSetting(data.Setting.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags = customSettingFieldValues(desc, data.Values)
+	resp.Diagnostics.Append(diags...)
+}
+
+// findCustomSettingRow returns the ID of the row with the key, empty if there is none
+func (r *customSettingValueResource) findCustomSettingRow(setting, keyField, key string) (string, error) {
+	query := force.BuildQuery("Id", setting, []string{fmt.Sprintf("%s = '%s'", keyField, soqlEscape(key))})
+	records, err := queryAllRecords[struct {
+		Id string `json:"Id"`
+	}](r.client, query)
+	if err != nil || len(records) == 0 {
+		return "", err
+	}
+	return records[0].Id, nil
+}
+
+func (r *customSettingValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data customSettingValueResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting := data.Setting.ValueString()
+	desc, diags := r.describeCustomSetting(setting)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	fields, diags := customSettingFieldValues(desc, data.Values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyField, key := data.key()
+	id, err := r.findCustomSettingRow(setting, keyField, key)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", setting), err.Error())
+		return
+	}
+	if id != "" {
+		if len(fields) > 0 {
+			if err := r.client.UpdateSObject(id, dynamicSObject{apiName: setting, fields: fields}); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Error Updating %s", setting), err.Error())
+				return
+			}
+		}
+	} else {
+		fields[keyField] = key
+		sfResp, err := r.client.InsertSObject(dynamicSObject{apiName: setting, fields: fields})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Inserting %s", setting), err.Error())
+			return
+		}
+		id = sfResp.Id
+	}
+	data.Id = types.StringValue(id)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customSettingValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data customSettingValueResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting := data.Setting.ValueString()
+	desc, err := describeSObject(r.client, setting)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Describing Custom Setting", err.Error())
+		return
+	}
+	describeFields := sobjectFieldsByName(desc)
+
+	// only read the fields under management
+	fieldNames := []string{"Id", "SetupOwnerId", "Name"}
+	for name := range data.Values.Elements() {
+		fieldNames = append(fieldNames, name)
+	}
+	sobject := dynamicSObject{apiName: setting}
+	if err := r.client.GetSObject(data.Id.ValueString(), fieldNames, &sobject); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", setting), err.Error())
+		return
+	}
+
+	// hierarchy settings generate the name of their rows and list settings belong to the org, only
+	// the attribute identifying the row is read
+	if !data.SetupOwnerId.IsNull() {
+		raw, _ := lookupSObjectField(sobject.fields, "SetupOwnerId")
+		if ownerId, ok := raw.(string); ok && normalizeId(ownerId) != normalizeId(data.SetupOwnerId.ValueString()) {
+			data.SetupOwnerId = types.StringValue(ownerId)
+		}
+	} else {
+		raw, _ := lookupSObjectField(sobject.fields, "Name")
+		if name, ok := raw.(string); ok {
+			data.Name = types.StringValue(name)
+		}
+	}
+
+	if !data.Values.IsNull() {
+		values := make(map[string]attr.Value, len(data.Values.Elements()))
+		for name, prior := range data.Values.Elements() {
+			raw, _ := lookupSObjectField(sobject.fields, name)
+			v, err := sobjectValueFromInterface(raw, prior, describeFields[strings.ToLower(name)])
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("values").AtMapKey(name), "Error reading custom setting value", err.Error())
+				return
+			}
+			values[name] = v
+		}
+		data.Values = types.MapValueMust(types.StringType, values)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customSettingValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state customSettingValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting := data.Setting.ValueString()
+	desc, diags := r.describeCustomSetting(setting)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// only send changed values
+	changed := make(map[string]attr.Value)
+	stateValues := state.Values.Elements()
+	for name, value := range data.Values.Elements() {
+		if prior, ok := stateValues[name]; !ok || !prior.Equal(value) {
+			changed[name] = value
+		}
+	}
+	fields, diags := customSettingFieldValues(desc, types.MapValueMust(types.StringType, changed))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(fields) > 0 {
+		if err := r.client.UpdateSObject(data.Id.ValueString(), dynamicSObject{apiName: setting, fields: fields}); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Updating %s", setting), err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *customSettingValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data customSettingValueResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the client panics on object types it didn't list at login, such as settings deleted since
+	setting := data.Setting.ValueString()
+	if _, diags := r.describeCustomSetting(setting); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if err := r.client.DeleteSObject(data.Id.ValueString(), dynamicSObject{apiName: setting}); err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Deleting %s", setting), err.Error())
+		return
+	}
+}
+
+func (r *customSettingValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || (parts[1] != "setup_owner_id" && parts[1] != "name") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: setting/setup_owner_id/id or setting/name/name. Got: %q", req.ID),
+		)
+		return
+	}
+	setting, attribute, key := parts[0], parts[1], parts[2]
+	keyField := "Name"
+	if attribute == "setup_owner_id" {
+		keyField, key = "SetupOwnerId", normalizeId(key)
+	}
+	id, err := r.findCustomSettingRow(setting, keyField, key)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", setting), err.Error())
+		return
+	}
+	if id == "" {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", setting), fmt.Sprintf("No %s where %s = %s", setting, keyField, key))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("setting"), setting)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), key)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("values"), types.MapNull(types.StringType))...)
+}
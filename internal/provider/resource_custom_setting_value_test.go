@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/nimajalali/go-force/force"
+)
+
+func TestCustomSettingFieldValues(t *testing.T) {
+	t.Parallel()
+
+	desc := &force.SObjectDescription{
+		Name:          "App_Config__c",
+		CustomSetting: true,
+		Fields: []*force.SObjectField{
+			{Name: "SetupOwnerId", Type: "reference"},
+			{Name: "Endpoint__c", Type: "url", Custom: true},
+			{Name: "Timeout__c", Type: "double", Custom: true},
+			{Name: "Debug__c", Type: "boolean", Custom: true},
+		},
+	}
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"endpoint__c": types.StringValue("https://api.example.com"),
+		"Timeout__c":  types.StringValue("30"),
+		"Debug__c":    types.StringNull(),
+	})
+	got, diags := customSettingFieldValues(desc, values)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors %v", diags)
+	}
+	want := map[string]interface{}{
+		"Endpoint__c": "https://api.example.com",
+		"Timeout__c":  float64(30),
+		"Debug__c":    nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	invalid := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"SetupOwnerId": types.StringValue("00D000000000001"),
+		"Timeout__c":   types.StringValue("soon"),
+	})
+	if _, diags := customSettingFieldValues(desc, invalid); diags.ErrorsCount() != 2 {
+		t.Errorf("expected 2 errors, got %v", diags)
+	}
+}
+
+func TestAccResourceCustomSettingValue_hierarchy(t *testing.T) {
+	t.Parallel()
+
+	setting := os.Getenv("SALESFORCE_HIERARCHY_CUSTOM_SETTING")
+	if setting == "" {
+		t.Skip("SALESFORCE_HIERARCHY_CUSTOM_SETTING must name a hierarchy custom setting with an Endpoint__c text field to run this test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomSettingValue_hierarchy(setting, "https://api.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("salesforce_custom_setting_value.test", "id"),
+					resource.TestCheckResourceAttr("salesforce_custom_setting_value.test", "values.Endpoint__c", "https://api.example.com"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_custom_setting_value.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccCustomSettingValueImportId("salesforce_custom_setting_value.test", "setup_owner_id"),
+				ImportStateVerify: true,
+				// values aren't imported
+				ImportStateVerifyIgnore: []string{"values"},
+			},
+			{
+				Config: testAccResourceCustomSettingValue_hierarchy(setting, "https://api2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_setting_value.test", "values.Endpoint__c", "https://api2.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCustomSettingValue_noKey(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "salesforce_custom_setting_value" "test" {
+  setting = "App_Config__c"
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of setup_owner_id or name must be set"),
+			},
+		},
+	})
+}
+
+func testAccCustomSettingValueImportId(name, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", name)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["setting"], attribute, rs.Primary.Attributes[attribute]), nil
+	}
+}
+
+func testAccResourceCustomSettingValue_hierarchy(setting, endpoint string) string {
+	return fmt.Sprintf(`
+data "salesforce_profile" "test" {
+  name = "Standard User"
+}
+
+resource "salesforce_custom_setting_value" "test" {
+  setting        = "%s"
+  setup_owner_id = data.salesforce_profile.test.id
+  values = {
+    Endpoint__c = "%s"
+  }
+}
+`, setting, endpoint)
+}
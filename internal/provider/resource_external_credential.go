@@ -0,0 +1,517 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+// externalCredentialAttributes maps the fields named by Metadata API errors to attributes
+var externalCredentialAttributes = map[string]path.Path{
+	"fullName":                     path.Root("name"),
+	"label":                        path.Root("label"),
+	"authenticationProtocol":       path.Root("authentication_protocol"),
+	"externalCredentialParameters": path.Root("parameters"),
+}
+
+var (
+	externalCredentialProtocols      = []string{"AwsSv4", "Basic", "Custom", "Jwt", "JwtExchange", "NoAuthentication", "Oauth"}
+	externalCredentialParameterTypes = []string{"AuthHeader", "AuthParameter", "AuthProvider", "AuthProviderUrl", "AuthProviderUrlQueryParameter", "JwtBodyClaim", "JwtHeaderClaim", "SigningCertificate"}
+	externalCredentialPrincipalTypes = []string{"NamedPrincipal", "PerUserPrincipal"}
+)
+
+type externalCredentialResource struct {
+	client   *force.ForceApi
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                   = &externalCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &externalCredentialResource{}
+	_ resource.ResourceWithImportState    = &externalCredentialResource{}
+)
+
+func (r *externalCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_external_credential"
+}
+
+func (r *externalCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "External Credential Resource for the Salesforce Provider, the authentication of callouts made through named credentials, managed through the Metadata API. The credentials of named principals are set through the Connect API and never read back.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the same as name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the external credential. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the external credential.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"authentication_protocol": schema.StringAttribute{
+				Description: fmt.Sprintf("Authentication protocol of the external credential, one of %s. Forces replacement if updated.", strings.Join(externalCredentialProtocols, ", ")),
+				Required:    true,
+				Validators: []validator.String{
+					stringInSlice{slice: externalCredentialProtocols},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the external credential.",
+				Optional:    true,
+			},
+			"parameters": schema.ListNestedAttribute{
+				Description: "Parameters of the authentication protocol, such as the headers of Custom credentials or the auth provider and scope of Oauth credentials. Headers are sent in the order they are listed.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the parameter, such as Authorization for a header or Scope for an auth parameter.",
+							Required:    true,
+							Validators: []validator.String{
+								notEmptyString{},
+							},
+						},
+						"type": schema.StringAttribute{
+							Description: fmt.Sprintf("Type of the parameter, one of %s.", strings.Join(externalCredentialParameterTypes, ", ")),
+							Required:    true,
+							Validators: []validator.String{
+								stringInSlice{slice: externalCredentialParameterTypes},
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "Value of the parameter. Header values can reference credentials with formulas such as {!$Credential.Name.ApiKey}. The value of an AuthProvider parameter is the name of the auth provider and the value of a SigningCertificate parameter the name of the certificate.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"principals": schema.ListNestedAttribute{
+				Description: "Principals of the external credential, mapped to permission sets with salesforce_permission_set_principal_access.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the principal.",
+							Required:    true,
+							Validators: []validator.String{
+								customName{},
+							},
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the principal, NamedPrincipal for a single identity shared by every user or PerUserPrincipal for an identity per user.",
+							Required:    true,
+							Validators: []validator.String{
+								stringInSlice{slice: externalCredentialPrincipalTypes},
+							},
+						},
+						"credentials": schema.MapAttribute{
+							Description: "Secret credentials of a NamedPrincipal by name, such as ApiKey for Custom credentials or Username and Password for Basic credentials. They are written through the Connect API and never read back, changes made outside of Terraform aren't detected or imported.",
+							Optional:    true,
+							Sensitive:   true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type externalCredentialResourceModel struct {
+	Id                     types.String                       `tfsdk:"id"`
+	Name                   types.String                       `tfsdk:"name"`
+	Label                  types.String                       `tfsdk:"label"`
+	AuthenticationProtocol types.String                       `tfsdk:"authentication_protocol"`
+	Description            types.String                       `tfsdk:"description"`
+	Parameters             []externalCredentialParameterModel `tfsdk:"parameters"`
+	Principals             []externalCredentialPrincipalModel `tfsdk:"principals"`
+}
+
+type externalCredentialParameterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type externalCredentialPrincipalModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Credentials types.Map    `tfsdk:"credentials"`
+}
+
+func (r *externalCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data externalCredentialResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := make(map[string]bool)
+	for i, parameter := range data.Parameters {
+		if parameter.Name.IsUnknown() || parameter.Type.IsUnknown() {
+			continue
+		}
+		key := parameter.Type.ValueString() + "/" + parameter.Name.ValueString()
+		if parameters[key] {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters").AtListIndex(i).AtName("name"), "Duplicate Parameter", fmt.Sprintf("The %s parameter %s is listed more than once.", parameter.Type.ValueString(), parameter.Name.ValueString()))
+		}
+		parameters[key] = true
+	}
+	principals := make(map[string]bool)
+	for i, principal := range data.Principals {
+		if principal.Name.IsUnknown() {
+			continue
+		}
+		if principals[principal.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("principals").AtListIndex(i).AtName("name"), "Duplicate Principal", fmt.Sprintf("The principal %s is listed more than once.", principal.Name.ValueString()))
+		}
+		principals[principal.Name.ValueString()] = true
+		if !principal.Credentials.IsNull() && principal.Type.ValueString() == "PerUserPrincipal" {
+			resp.Diagnostics.AddAttributeError(path.Root("principals").AtListIndex(i).AtName("credentials"), "Unexpected Credentials", "Credentials can only be set for a NamedPrincipal, the credentials of a PerUserPrincipal are set by each user.")
+		}
+	}
+}
+
+func expandExternalCredential(data externalCredentialResourceModel) metadata.ExternalCredential {
+	credential := metadata.ExternalCredential{
+		FullName:               data.Name.ValueString(),
+		AuthenticationProtocol: data.AuthenticationProtocol.ValueString(),
+		Description:            data.Description.ValueString(),
+		Label:                  data.Label.ValueString(),
+	}
+	var headers int64
+	for _, p := range data.Parameters {
+		parameter := metadata.ExternalCredentialParameter{
+			ParameterName: p.Name.ValueString(),
+			ParameterType: p.Type.ValueString(),
+		}
+		switch parameter.ParameterType {
+		case "AuthProvider":
+			parameter.AuthProvider = p.Value.ValueString()
+		case "SigningCertificate":
+			parameter.Certificate = p.Value.ValueString()
+		case "AuthHeader":
+			headers++
+			parameter.SequenceNumber = int64Pointer(headers)
+			parameter.ParameterValue = p.Value.ValueString()
+		default:
+			parameter.ParameterValue = p.Value.ValueString()
+		}
+		credential.ExternalCredentialParameters = append(credential.ExternalCredentialParameters, parameter)
+	}
+	for i, p := range data.Principals {
+		credential.ExternalCredentialParameters = append(credential.ExternalCredentialParameters, metadata.ExternalCredentialParameter{
+			ParameterName:  p.Name.ValueString(),
+			ParameterType:  p.Type.ValueString(),
+			SequenceNumber: int64Pointer(int64(i + 1)),
+		})
+	}
+	return credential
+}
+
+// flattenExternalCredential reads the credential into data keeping the order of parameters and
+// principals in data, the credentials of principals are never read and are kept from data
+func flattenExternalCredential(data *externalCredentialResourceModel, credential metadata.ExternalCredential) {
+	data.Id = types.StringValue(credential.FullName)
+	data.Name = types.StringValue(credential.FullName)
+	data.Label = types.StringValue(credential.Label)
+	data.AuthenticationProtocol = types.StringValue(credential.AuthenticationProtocol)
+	data.Description = metadataString(credential.Description)
+
+	parameterOrder := make(map[string]int, len(data.Parameters))
+	for i, p := range data.Parameters {
+		parameterOrder[p.Type.ValueString()+"/"+p.Name.ValueString()] = i
+	}
+	prior := make(map[string]externalCredentialPrincipalModel, len(data.Principals))
+	principalOrder := make(map[string]int, len(data.Principals))
+	for i, p := range data.Principals {
+		prior[p.Name.ValueString()] = p
+		principalOrder[p.Name.ValueString()] = i
+	}
+
+	var parameters []externalCredentialParameterModel
+	var principals []externalCredentialPrincipalModel
+	for _, p := range credential.ExternalCredentialParameters {
+		if contains(externalCredentialPrincipalTypes, p.ParameterType) {
+			principal := externalCredentialPrincipalModel{
+				Name:        types.StringValue(p.ParameterName),
+				Type:        types.StringValue(p.ParameterType),
+				Credentials: types.MapNull(types.StringType),
+			}
+			if before, ok := prior[p.ParameterName]; ok {
+				principal.Credentials = before.Credentials
+			}
+			principals = append(principals, principal)
+			continue
+		}
+		value := p.ParameterValue
+		switch p.ParameterType {
+		case "AuthProvider":
+			value = p.AuthProvider
+		case "SigningCertificate":
+			value = p.Certificate
+		}
+		parameters = append(parameters, externalCredentialParameterModel{
+			Name:  types.StringValue(p.ParameterName),
+			Type:  types.StringValue(p.ParameterType),
+			Value: types.StringValue(value),
+		})
+	}
+
+	sortByPrior(parameters, parameterOrder, func(p externalCredentialParameterModel) string {
+		return p.Type.ValueString() + "/" + p.Name.ValueString()
+	})
+	sortByPrior(principals, principalOrder, func(p externalCredentialPrincipalModel) string {
+		return p.Name.ValueString()
+	})
+	data.Parameters = parameters
+	data.Principals = principals
+}
+
+// sortByPrior stably sorts items by their index in order, items that aren't in order go last
+func sortByPrior[T any](items []T, order map[string]int, key func(T) string) {
+	index := func(item T) int {
+		if i, ok := order[key(item)]; ok {
+			return i
+		}
+		return len(order)
+	}
+	for i := 1; i < len(items); i++ {
+		for j := i; j > 0 && index(items[j]) < index(items[j-1]); j-- {
+			items[j], items[j-1] = items[j-1], items[j]
+		}
+	}
+}
+
+// principalCredentialInput is the body of the Connect API request setting the credentials of a principal
+type principalCredentialInput struct {
+	ExternalCredential     string                              `force:"externalCredential"`
+	PrincipalName          string                              `force:"principalName"`
+	PrincipalType          string                              `force:"principalType"`
+	AuthenticationProtocol string                              `force:"authenticationProtocol"`
+	Credentials            map[string]principalCredentialValue `force:"credentials"`
+}
+
+type principalCredentialValue struct {
+	Value     string `force:"value"`
+	Encrypted bool   `force:"encrypted"`
+}
+
+// principalCredentialsPath is the path of the Connect API credentials of named credentials
+func principalCredentialsPath(apiVersion string) string {
+	return fmt.Sprintf("/services/data/v%s/named-credentials/credential", strings.TrimPrefix(apiVersion, "v"))
+}
+
+// connectAuthenticationProtocol returns the Connect API name of a Metadata API authentication protocol
+func connectAuthenticationProtocol(protocol string) string {
+	if protocol == "Oauth" {
+		return "OAuth"
+	}
+	return protocol
+}
+
+// setPrincipalCredentials creates or replaces the credentials of a named principal, every
+// credential is stored encrypted
+func (r *externalCredentialResource) setPrincipalCredentials(ctx context.Context, data externalCredentialResourceModel, principal externalCredentialPrincipalModel, replace bool) diag.Diagnostics {
+	var credentials map[string]string
+	diags := principal.Credentials.ElementsAs(ctx, &credentials, false)
+	if diags.HasError() {
+		return diags
+	}
+	input := principalCredentialInput{
+		ExternalCredential:     data.Name.ValueString(),
+		PrincipalName:          principal.Name.ValueString(),
+		PrincipalType:          principal.Type.ValueString(),
+		AuthenticationProtocol: connectAuthenticationProtocol(data.AuthenticationProtocol.ValueString()),
+		Credentials:            make(map[string]principalCredentialValue, len(credentials)),
+	}
+	for name, value := range credentials {
+		input.Credentials[name] = principalCredentialValue{Value: value, Encrypted: true}
+	}
+
+	var err error
+	if replace {
+		err = r.client.Put(principalCredentialsPath(r.metadata.ApiVersion), nil, input, nil)
+	} else {
+		err = r.client.Post(principalCredentialsPath(r.metadata.ApiVersion), nil, input, nil)
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("principals"), "Error Setting Principal Credentials", fmt.Sprintf("Credentials of principal %s: %s", principal.Name.ValueString(), err))
+	}
+	return diags
+}
+
+// deletePrincipalCredentials removes the credentials of a named principal
+func (r *externalCredentialResource) deletePrincipalCredentials(data externalCredentialResourceModel, principal externalCredentialPrincipalModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	params := url.Values{
+		"externalCredential": {data.Name.ValueString()},
+		"principalName":      {principal.Name.ValueString()},
+		"principalType":      {principal.Type.ValueString()},
+	}
+	if err := r.client.Delete(principalCredentialsPath(r.metadata.ApiVersion), params); err != nil && !isNotFoundError(err) {
+		diags.AddAttributeError(path.Root("principals"), "Error Deleting Principal Credentials", fmt.Sprintf("Credentials of principal %s: %s", principal.Name.ValueString(), err))
+	}
+	return diags
+}
+
+func (r *externalCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data externalCredentialResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Create(ctx, expandExternalCredential(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating External Credential", err, externalCredentialAttributes)...)
+		return
+	}
+	data.Id = data.Name
+
+	// the credential exists now, keep it in state even when setting the credentials of a principal fails
+	var set []externalCredentialPrincipalModel
+	for _, principal := range data.Principals {
+		if !principal.Credentials.IsNull() {
+			diags := r.setPrincipalCredentials(ctx, data, principal, false)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				principal.Credentials = types.MapNull(types.StringType)
+			}
+		}
+		set = append(set, principal)
+	}
+	data.Principals = set
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *externalCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data externalCredentialResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, found, err := metadata.Read[metadata.ExternalCredential](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading External Credential", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenExternalCredential(&data, credential)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *externalCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state externalCredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Update(ctx, expandExternalCredential(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating External Credential", err, externalCredentialAttributes)...)
+		return
+	}
+
+	// principals that were removed or changed type lost their credentials with the update
+	prior := make(map[string]externalCredentialPrincipalModel, len(state.Principals))
+	for _, principal := range state.Principals {
+		prior[principal.Name.ValueString()] = principal
+	}
+	var set []externalCredentialPrincipalModel
+	for _, principal := range data.Principals {
+		before, existed := prior[principal.Name.ValueString()]
+		existed = existed && before.Type.Equal(principal.Type) && !before.Credentials.IsNull()
+		switch {
+		case principal.Credentials.IsNull() && existed:
+			diags := r.deletePrincipalCredentials(data, before)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				principal.Credentials = before.Credentials
+			}
+		case !principal.Credentials.IsNull() && !principal.Credentials.Equal(before.Credentials):
+			diags := r.setPrincipalCredentials(ctx, data, principal, existed)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				principal.Credentials = types.MapNull(types.StringType)
+				if existed {
+					principal.Credentials = before.Credentials
+				}
+			}
+		}
+		set = append(set, principal)
+	}
+	data.Principals = set
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *externalCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data externalCredentialResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the credentials of principals are deleted with the external credential
+	if err := r.metadata.Delete(ctx, "ExternalCredential", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting External Credential", err.Error())
+		return
+	}
+}
+
+func (r *externalCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if checkCustomName(req.ID, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: External_Credential_Name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func TestExpandExternalCredential(t *testing.T) {
+	t.Parallel()
+
+	data := externalCredentialResourceModel{
+		Name:                   types.StringValue("Payments"),
+		Label:                  types.StringValue("Payments"),
+		AuthenticationProtocol: types.StringValue("Oauth"),
+		Parameters: []externalCredentialParameterModel{
+			{Name: types.StringValue("AuthProvider"), Type: types.StringValue("AuthProvider"), Value: types.StringValue("Payments_Provider")},
+			{Name: types.StringValue("Scope"), Type: types.StringValue("AuthParameter"), Value: types.StringValue("payments.read")},
+			{Name: types.StringValue("X-Tenant"), Type: types.StringValue("AuthHeader"), Value: types.StringValue("acme")},
+		},
+		Principals: []externalCredentialPrincipalModel{
+			{Name: types.StringValue("Integration"), Type: types.StringValue("NamedPrincipal"), Credentials: types.MapNull(types.StringType)},
+			{Name: types.StringValue("Users"), Type: types.StringValue("PerUserPrincipal"), Credentials: types.MapNull(types.StringType)},
+		},
+	}
+	credential := expandExternalCredential(data)
+	parameters := credential.ExternalCredentialParameters
+	if len(parameters) != 5 {
+		t.Fatalf("expected 5 parameters, got %#v", parameters)
+	}
+	if parameters[0].AuthProvider != "Payments_Provider" || parameters[0].ParameterValue != "" {
+		t.Errorf("expected the auth provider in authProvider, got %#v", parameters[0])
+	}
+	if parameters[1].SequenceNumber != nil || parameters[2].SequenceNumber == nil || *parameters[2].SequenceNumber != 1 {
+		t.Errorf("expected only the header to be sequenced, got %#v and %#v", parameters[1], parameters[2])
+	}
+	if parameters[4].ParameterType != "PerUserPrincipal" || parameters[4].SequenceNumber == nil || *parameters[4].SequenceNumber != 2 {
+		t.Errorf("unexpected principal %#v", parameters[4])
+	}
+}
+
+func TestFlattenExternalCredential(t *testing.T) {
+	t.Parallel()
+
+	credentials := types.MapValueMust(types.StringType, map[string]attr.Value{"ApiKey": types.StringValue("secret")})
+	data := externalCredentialResourceModel{
+		Parameters: []externalCredentialParameterModel{
+			{Name: types.StringValue("X-Version"), Type: types.StringValue("AuthHeader"), Value: types.StringValue("2")},
+			{Name: types.StringValue("Authorization"), Type: types.StringValue("AuthHeader"), Value: types.StringValue("Bearer {!$Credential.Payments.ApiKey}")},
+		},
+		Principals: []externalCredentialPrincipalModel{
+			{Name: types.StringValue("Integration"), Type: types.StringValue("NamedPrincipal"), Credentials: credentials},
+		},
+	}
+	flattenExternalCredential(&data, metadata.ExternalCredential{
+		FullName:               "Payments",
+		AuthenticationProtocol: "Custom",
+		Label:                  "Payments",
+		ExternalCredentialParameters: []metadata.ExternalCredentialParameter{
+			{ParameterName: "Authorization", ParameterType: "AuthHeader", ParameterValue: "Bearer {!$Credential.Payments.ApiKey}", SequenceNumber: int64Pointer(2)},
+			{ParameterName: "Integration", ParameterType: "NamedPrincipal", SequenceNumber: int64Pointer(1)},
+			{ParameterName: "Signing", ParameterType: "SigningCertificate", Certificate: "Payments_Cert"},
+			{ParameterName: "X-Version", ParameterType: "AuthHeader", ParameterValue: "2", SequenceNumber: int64Pointer(1)},
+		},
+	})
+	if len(data.Parameters) != 3 {
+		t.Fatalf("expected 3 parameters, got %#v", data.Parameters)
+	}
+	if data.Parameters[0].Name.ValueString() != "X-Version" || data.Parameters[1].Name.ValueString() != "Authorization" {
+		t.Errorf("expected the order of the prior parameters to be kept, got %#v", data.Parameters)
+	}
+	if data.Parameters[2].Value.ValueString() != "Payments_Cert" {
+		t.Errorf("expected the certificate as the value, got %#v", data.Parameters[2])
+	}
+	if len(data.Principals) != 1 || !data.Principals[0].Credentials.Equal(credentials) {
+		t.Errorf("expected the credentials of the principal to be kept, got %#v", data.Principals)
+	}
+	if !data.Description.IsNull() {
+		t.Errorf("expected the unset description to be null, got %s", data.Description)
+	}
+}
+
+func TestPrincipalCredentialsPath(t *testing.T) {
+	t.Parallel()
+
+	for _, version := range []string{"53.0", "v53.0"} {
+		if got := principalCredentialsPath(version); got != "/services/data/v53.0/named-credentials/credential" {
+			t.Errorf("principalCredentialsPath(%q) = %q", version, got)
+		}
+	}
+}
+
+func TestAccResourceExternalCredential_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceExternalCredential_basic(name, "first-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_external_credential.test", "id", name),
+					resource.TestCheckResourceAttr("salesforce_external_credential.test", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("salesforce_external_credential.test", "principals.#", "1"),
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "external_credential", name),
+				),
+			},
+			{
+				ResourceName:            "salesforce_external_credential.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"principals.0.credentials"},
+			},
+			{
+				Config: testAccResourceExternalCredential_basic(name, "second-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_external_credential.test", "principals.0.credentials.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceExternalCredential_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "salesforce_external_credential" "test" {
+  name                    = "tf_test_invalid"
+  label                   = "Invalid"
+  authentication_protocol = "Custom"
+  principals = [
+    { name = "Users", type = "PerUserPrincipal", credentials = { ApiKey = "secret" } },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Credentials can only be set for a NamedPrincipal"),
+			},
+		},
+	})
+}
+
+func testAccResourceExternalCredential_basic(name, apiKey string) string {
+	return fmt.Sprintf(`
+resource "salesforce_external_credential" "test" {
+  name                    = "%[1]s"
+  label                   = "Terraform Test"
+  authentication_protocol = "Custom"
+  parameters = [
+    { name = "Authorization", type = "AuthHeader", value = "Bearer {!$Credential.%[1]s.ApiKey}" },
+  ]
+  principals = [
+    { name = "Integration", type = "NamedPrincipal", credentials = { ApiKey = "%[2]s" } },
+  ]
+}
+
+resource "salesforce_named_credential" "test" {
+  name                = "%[1]s"
+  label               = "Terraform Test"
+  url                 = "https://api.example.com/v1"
+  external_credential = salesforce_external_credential.test.name
+}
+`, name, apiKey)
+}
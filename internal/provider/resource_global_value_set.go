@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+type globalValueSetResource struct {
+	client   *force.ForceApi
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                   = &globalValueSetResource{}
+	_ resource.ResourceWithValidateConfig = &globalValueSetResource{}
+	_ resource.ResourceWithModifyPlan     = &globalValueSetResource{}
+	_ resource.ResourceWithImportState    = &globalValueSetResource{}
+)
+
+func (r *globalValueSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_global_value_set"
+}
+
+func (r *globalValueSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := valueSetAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the resource, the name of the value set.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "API name of the value set. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+		Required:    true,
+		Validators: []validator.String{
+			customName{},
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["label"] = schema.StringAttribute{
+		Description: "Label of the value set.",
+		Required:    true,
+		Validators: []validator.String{
+			notEmptyString{},
+		},
+	}
+	attributes["description"] = schema.StringAttribute{
+		Description: "Description of the value set.",
+		Optional:    true,
+	}
+	attributes["used_by_fields"] = schema.ListAttribute{
+		Description: "Picklist fields using the value set, in the format Object.Field__c. When values are removed, the plan warns about the records of these fields that still have them. Defaults to the fields found through the metadata dependencies of the Tooling API.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Global Value Set Resource for the Salesforce Provider, a set of picklist values shared by picklist fields, managed through the Metadata API.",
+		Attributes:  attributes,
+	}
+}
+
+type globalValueSetResourceModel struct {
+	Id             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	Label          types.String         `tfsdk:"label"`
+	Description    types.String         `tfsdk:"description"`
+	UsedByFields   []types.String       `tfsdk:"used_by_fields"`
+	Values         []valueSetValueModel `tfsdk:"values"`
+	DefaultValue   types.String         `tfsdk:"default_value"`
+	Sorted         types.Bool           `tfsdk:"sorted"`
+	InactiveValues types.List           `tfsdk:"inactive_values"`
+}
+
+func expandGlobalValueSet(data globalValueSetResourceModel, current []metadata.CustomValue) metadata.GlobalValueSet {
+	return metadata.GlobalValueSet{
+		FullName:     data.Name.ValueString(),
+		MasterLabel:  data.Label.ValueString(),
+		Description:  data.Description.ValueString(),
+		Sorted:       data.Sorted.ValueBool(),
+		CustomValues: expandValueSetValues(data.Values, data.DefaultValue, current),
+	}
+}
+
+func (r *globalValueSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data globalValueSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateValueSetValues(data.Values, data.DefaultValue)...)
+}
+
+func (r *globalValueSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state globalValueSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removed := removedValueSetValues(state.Values, plan.Values)
+	if len(removed) == 0 || r.client == nil {
+		return
+	}
+	var fields []string
+	for _, field := range plan.UsedByFields {
+		if !field.IsUnknown() && !field.IsNull() {
+			fields = append(fields, field.ValueString())
+		}
+	}
+	if plan.UsedByFields == nil {
+		var err error
+		fields, err = globalValueSetFields(r.client, r.metadata.ApiVersion, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("used_by_fields"),
+				"Unable to check value usage",
+				fmt.Sprintf("Couldn't find the fields using %s, records that still have the removed values weren't checked, set used_by_fields to check them: %s", state.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+	resp.Diagnostics.Append(valueSetUsageWarnings(r.client, fields, removed)...)
+}
+
+func (r *globalValueSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data globalValueSetResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := expandGlobalValueSet(data, nil)
+	if err := r.metadata.Create(ctx, set); err != nil {
+		resp.Diagnostics.AddError("Error Creating Global Value Set", err.Error())
+		return
+	}
+	data.Id = data.Name
+	data.InactiveValues = inactiveValueSetValues(data.Values, set.CustomValues)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *globalValueSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data globalValueSetResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, found, err := metadata.Read[metadata.GlobalValueSet](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Global Value Set", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(set.FullName)
+	data.Name = types.StringValue(set.FullName)
+	data.Label = types.StringValue(set.MasterLabel)
+	data.Description = metadataString(set.Description)
+	data.Sorted = types.BoolValue(set.Sorted)
+	values, inactive, defaultValue := flattenValueSetValues(data.Values, set.CustomValues)
+	data.Values = values
+	data.DefaultValue = defaultValue
+	data.InactiveValues, diags = types.ListValueFrom(ctx, types.StringType, inactive)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *globalValueSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data globalValueSetResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// read the current values so the removed ones are deactivated rather than deleted
+	current, _, err := metadata.Read[metadata.GlobalValueSet](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Global Value Set", err.Error())
+		return
+	}
+	set := expandGlobalValueSet(data, current.CustomValues)
+	if err := r.metadata.Update(ctx, set); err != nil {
+		resp.Diagnostics.AddError("Error Updating Global Value Set", err.Error())
+		return
+	}
+	data.InactiveValues = inactiveValueSetValues(data.Values, set.CustomValues)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *globalValueSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data globalValueSetResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "GlobalValueSet", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Global Value Set", err.Error())
+		return
+	}
+}
+
+func (r *globalValueSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
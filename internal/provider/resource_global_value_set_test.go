@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func testValueSetValue(value string) valueSetValueModel {
+	return valueSetValueModel{Value: types.StringValue(value), Label: types.StringNull(), Active: types.BoolNull()}
+}
+
+func TestValidateValueSetValues(t *testing.T) {
+	t.Parallel()
+
+	inactive := testValueSetValue("Silver")
+	inactive.Active = types.BoolValue(false)
+	values := []valueSetValueModel{testValueSetValue("Gold"), inactive, testValueSetValue("gold")}
+
+	if diags := validateValueSetValues(values[:2], types.StringValue("Gold")); diags.HasError() {
+		t.Errorf("unexpected errors %v", diags)
+	}
+	if diags := validateValueSetValues(values, types.StringNull()); len(diags) != 1 {
+		t.Errorf("expected the duplicate value error, got %v", diags)
+	}
+	if diags := validateValueSetValues(values[:2], types.StringValue("Silver")); len(diags) != 1 {
+		t.Errorf("expected the inactive default error, got %v", diags)
+	}
+	if diags := validateValueSetValues(values[:2], types.StringValue("Bronze")); len(diags) != 1 {
+		t.Errorf("expected the missing default error, got %v", diags)
+	}
+}
+
+func TestExpandValueSetValues(t *testing.T) {
+	t.Parallel()
+
+	labeled := testValueSetValue("Gold")
+	labeled.Label = types.StringValue("Gold Tier")
+	current := []metadata.CustomValue{
+		{FullName: "Silver", Label: "Silver", Default: true, IsActive: boolPointer(true)},
+		{FullName: "Gold", Label: "Gold", IsActive: boolPointer(true)},
+	}
+	got := expandValueSetValues([]valueSetValueModel{labeled, testValueSetValue("Bronze")}, types.StringValue("Bronze"), current)
+	want := []metadata.CustomValue{
+		{FullName: "Gold", Label: "Gold Tier", IsActive: boolPointer(true)},
+		{FullName: "Bronze", Label: "Bronze", Default: true, IsActive: boolPointer(true)},
+		{FullName: "Silver", Label: "Silver", IsActive: boolPointer(false)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+	if inactive := inactiveValueSetValues([]valueSetValueModel{labeled}, got); inactive.String() != `["Silver"]` {
+		t.Errorf("unexpected inactive values %s", inactive)
+	}
+}
+
+func TestFlattenValueSetValues(t *testing.T) {
+	t.Parallel()
+
+	prior := []valueSetValueModel{testValueSetValue("Gold"), testValueSetValue("Silver")}
+	current := []metadata.CustomValue{
+		{FullName: "Gold", Label: "Gold", Default: true},
+		{FullName: "Silver", Label: "Silver Tier", IsActive: boolPointer(false)},
+		{FullName: "Bronze", Label: "Bronze", IsActive: boolPointer(false)},
+		{FullName: "Platinum", Label: "Platinum"},
+	}
+	values, inactive, defaultValue := flattenValueSetValues(prior, current)
+	want := []valueSetValueModel{
+		testValueSetValue("Gold"),
+		{Value: types.StringValue("Silver"), Label: types.StringValue("Silver Tier"), Active: types.BoolValue(false)},
+		testValueSetValue("Platinum"),
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("expected %+v, got %+v", want, values)
+	}
+	if !reflect.DeepEqual(inactive, []string{"Bronze"}) {
+		t.Errorf("unexpected inactive values %v", inactive)
+	}
+	if defaultValue.ValueString() != "Gold" {
+		t.Errorf("unexpected default value %s", defaultValue)
+	}
+}
+
+func TestRemovedValueSetValues(t *testing.T) {
+	t.Parallel()
+
+	deactivated := testValueSetValue("Silver")
+	deactivated.Active = types.BoolValue(false)
+	state := []valueSetValueModel{testValueSetValue("Gold"), testValueSetValue("Silver"), testValueSetValue("Bronze")}
+	plan := []valueSetValueModel{testValueSetValue("Gold"), deactivated}
+	if removed := removedValueSetValues(state, plan); !reflect.DeepEqual(removed, []string{"Silver", "Bronze"}) {
+		t.Errorf("unexpected removed values %v", removed)
+	}
+	if removed := removedValueSetValues(state, []valueSetValueModel{{Value: types.StringUnknown()}}); removed != nil {
+		t.Errorf("expected no removed values while unknown, got %v", removed)
+	}
+}
+
+func TestAccResourceGlobalValueSet_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGlobalValueSet_basic(name, `["Gold", "Silver", "Bronze"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "id", name),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "values.#", "3"),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "values.2.value", "Bronze"),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "default_value", "Silver"),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "inactive_values.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_global_value_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceGlobalValueSet_basic(name, `["Silver", "Gold"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "values.0.value", "Silver"),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "inactive_values.#", "1"),
+					resource.TestCheckResourceAttr("salesforce_global_value_set.test", "inactive_values.0", "Bronze"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGlobalValueSet_basic(name, values string) string {
+	return fmt.Sprintf(`
+resource "salesforce_global_value_set" "test" {
+  name          = "%s"
+  label         = "Terraform Test"
+  description   = "Managed by Terraform"
+  default_value = "Silver"
+  values        = [for v in %s : { value = v }]
+}
+`, name, values)
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+// namedCredentialAttributes maps the fields named by Metadata API errors to attributes
+var namedCredentialAttributes = map[string]path.Path{
+	"fullName":                  path.Root("name"),
+	"label":                     path.Root("label"),
+	"namedCredentialParameters": path.Root("url"),
+}
+
+type namedCredentialResource struct {
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                = &namedCredentialResource{}
+	_ resource.ResourceWithImportState = &namedCredentialResource{}
+)
+
+func (r *namedCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_named_credential"
+}
+
+func (r *namedCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Named Credential Resource for the Salesforce Provider, the endpoint of Apex callouts authenticated by an external credential, managed through the Metadata API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the same as name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the named credential, used in callout endpoints such as callout:Name/path. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the named credential.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the endpoint, callouts append their path to it.",
+				Required:    true,
+				Validators: []validator.String{
+					siteURL{schemes: []string{"https", "http"}, paths: true},
+				},
+			},
+			"external_credential": schema.StringAttribute{
+				Description: "Name of the external credential authenticating callouts.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the named credential.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether callouts through the named credential are enabled. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsTrue{},
+				},
+			},
+			"generate_authorization_header": schema.BoolAttribute{
+				Description: "Whether Salesforce generates the authorization header of callouts from the external credential. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsTrue{},
+				},
+			},
+			"allow_merge_fields_in_header": schema.BoolAttribute{
+				Description: "Whether Apex can use merge fields such as {!$Credential.Name.ApiKey} in the headers of callouts. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"allow_merge_fields_in_body": schema.BoolAttribute{
+				Description: "Whether Apex can use merge fields in the body of callouts. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+		},
+	}
+}
+
+type namedCredentialResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Label                       types.String `tfsdk:"label"`
+	Url                         types.String `tfsdk:"url"`
+	ExternalCredential          types.String `tfsdk:"external_credential"`
+	Description                 types.String `tfsdk:"description"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	GenerateAuthorizationHeader types.Bool   `tfsdk:"generate_authorization_header"`
+	AllowMergeFieldsInHeader    types.Bool   `tfsdk:"allow_merge_fields_in_header"`
+	AllowMergeFieldsInBody      types.Bool   `tfsdk:"allow_merge_fields_in_body"`
+}
+
+func expandNamedCredential(data namedCredentialResourceModel) metadata.NamedCredential {
+	status := "Enabled"
+	if !data.Enabled.ValueBool() {
+		status = "Disabled"
+	}
+	return metadata.NamedCredential{
+		FullName:                    data.Name.ValueString(),
+		AllowMergeFieldsInBody:      data.AllowMergeFieldsInBody.ValueBool(),
+		AllowMergeFieldsInHeader:    data.AllowMergeFieldsInHeader.ValueBool(),
+		CalloutStatus:               status,
+		Description:                 data.Description.ValueString(),
+		GenerateAuthorizationHeader: data.GenerateAuthorizationHeader.ValueBool(),
+		Label:                       data.Label.ValueString(),
+		NamedCredentialParameters: []metadata.NamedCredentialParameter{
+			{ParameterName: "Url", ParameterType: "Url", ParameterValue: data.Url.ValueString()},
+			{ParameterName: "ExternalCredential", ParameterType: "Authentication", ExternalCredential: data.ExternalCredential.ValueString()},
+		},
+		NamedCredentialType: "SecuredEndpoint",
+	}
+}
+
+func flattenNamedCredential(data *namedCredentialResourceModel, credential metadata.NamedCredential) {
+	data.Id = types.StringValue(credential.FullName)
+	data.Name = types.StringValue(credential.FullName)
+	data.Label = types.StringValue(credential.Label)
+	data.Description = metadataString(credential.Description)
+	data.Enabled = types.BoolValue(credential.CalloutStatus != "Disabled")
+	data.GenerateAuthorizationHeader = types.BoolValue(credential.GenerateAuthorizationHeader)
+	data.AllowMergeFieldsInHeader = types.BoolValue(credential.AllowMergeFieldsInHeader)
+	data.AllowMergeFieldsInBody = types.BoolValue(credential.AllowMergeFieldsInBody)
+	data.Url = types.StringNull()
+	data.ExternalCredential = types.StringNull()
+	for _, p := range credential.NamedCredentialParameters {
+		switch p.ParameterType {
+		case "Url":
+			data.Url = types.StringValue(p.ParameterValue)
+		case "Authentication":
+			data.ExternalCredential = types.StringValue(p.ExternalCredential)
+		}
+	}
+}
+
+func (r *namedCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data namedCredentialResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Create(ctx, expandNamedCredential(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating Named Credential", err, namedCredentialAttributes)...)
+		return
+	}
+	data.Id = data.Name
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *namedCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data namedCredentialResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, found, err := metadata.Read[metadata.NamedCredential](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Named Credential", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenNamedCredential(&data, credential)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *namedCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data namedCredentialResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Update(ctx, expandNamedCredential(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating Named Credential", err, namedCredentialAttributes)...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *namedCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data namedCredentialResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "NamedCredential", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Named Credential", err.Error())
+		return
+	}
+}
+
+func (r *namedCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if checkCustomName(req.ID, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Named_Credential_Name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestNamedCredentialRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := namedCredentialResourceModel{
+		Name:                        types.StringValue("Payments"),
+		Label:                       types.StringValue("Payments"),
+		Url:                         types.StringValue("https://api.example.com/v1"),
+		ExternalCredential:          types.StringValue("Payments"),
+		Description:                 types.StringNull(),
+		Enabled:                     types.BoolValue(false),
+		GenerateAuthorizationHeader: types.BoolValue(true),
+		AllowMergeFieldsInHeader:    types.BoolValue(true),
+		AllowMergeFieldsInBody:      types.BoolValue(false),
+	}
+	credential := expandNamedCredential(data)
+	if credential.CalloutStatus != "Disabled" || len(credential.NamedCredentialParameters) != 2 {
+		t.Fatalf("unexpected named credential %#v", credential)
+	}
+
+	var read namedCredentialResourceModel
+	flattenNamedCredential(&read, credential)
+	read.Id = types.StringNull()
+	data.Id = types.StringNull()
+	if read != data {
+		t.Errorf("expected %#v, got %#v", data, read)
+	}
+}
+
+func TestAccResourceNamedCredential_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNamedCredential_basic(name, "https://api.example.com/v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "id", name),
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "generate_authorization_header", "true"),
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "allow_merge_fields_in_body", "false"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_named_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceNamedCredential_basic(name, "https://api.example.com/v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "url", "https://api.example.com/v2"),
+					resource.TestCheckResourceAttr("salesforce_named_credential.test", "enabled", "false"),
+				),
+			},
+			{
+				Config:      testAccResourceNamedCredential_basic(name, "https://api.example.com/v2?key=1", false),
+				ExpectError: regexp.MustCompile("must only have a scheme, a host and optionally a port and a path"),
+			},
+		},
+	})
+}
+
+func testAccResourceNamedCredential_basic(name, url string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "salesforce_external_credential" "test" {
+  name                    = "%[1]s"
+  label                   = "Terraform Test"
+  authentication_protocol = "NoAuthentication"
+  principals = [
+    { name = "Anonymous", type = "NamedPrincipal" },
+  ]
+}
+
+resource "salesforce_named_credential" "test" {
+  name                          = "%[1]s"
+  label                         = "Terraform Test"
+  url                           = "%[2]s"
+  external_credential           = salesforce_external_credential.test.name
+  enabled                       = %[3]t
+  generate_authorization_header = true
+  description                   = "Managed by Terraform"
+}
+`, name, url, enabled)
+}
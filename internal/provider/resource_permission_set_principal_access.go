@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type permissionSetPrincipalAccessResource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ resource.Resource                = &permissionSetPrincipalAccessResource{}
+	_ resource.ResourceWithImportState = &permissionSetPrincipalAccessResource{}
+)
+
+func (r *permissionSetPrincipalAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_permission_set_principal_access"
+}
+
+func (r *permissionSetPrincipalAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Permission Set Principal Access Resource for the Salesforce Provider, gives the users of a permission set access to a principal of an external credential. Other settings of the permission set are left alone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the ID of the SetupEntityAccess record.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_set_id": schema.StringAttribute{
+				Description: "ID of the permission set. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_credential": schema.StringAttribute{
+				Description: "Name of the external credential. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Description: "Name of the principal of the external credential. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "ID of the ExternalCredentialParameter record of the principal.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type permissionSetPrincipalAccessResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	PermissionSetId    types.String `tfsdk:"permission_set_id"`
+	ExternalCredential types.String `tfsdk:"external_credential"`
+	Principal          types.String `tfsdk:"principal"`
+	PrincipalId        types.String `tfsdk:"principal_id"`
+}
+
+// principalRecord is an ExternalCredentialParameter of a principal returned by a query
+type principalRecord struct {
+	Id                 string `json:"Id"`
+	ParameterName      string `json:"ParameterName"`
+	ExternalCredential struct {
+		DeveloperName string `json:"DeveloperName"`
+	} `json:"ExternalCredential"`
+}
+
+// setupEntityAccessRecord is a SetupEntityAccess returned by a query
+type setupEntityAccessRecord struct {
+	Id            string `json:"Id"`
+	ParentId      string `json:"ParentId"`
+	SetupEntityId string `json:"SetupEntityId"`
+}
+
+// queryPrincipals returns the principals matching the conditions
+func queryPrincipals(client *force.ForceApi, conditions ...string) ([]principalRecord, error) {
+	query := force.BuildQuery(
+		"Id, ParameterName, ExternalCredential.DeveloperName",
+		"ExternalCredentialParameter",
+		append(conditions, "ParameterType IN ('NamedPrincipal', 'PerUserPrincipal')"),
+	)
+	return queryAllRecords[principalRecord](client, query)
+}
+
+// findPrincipal returns the ID of the principal of the external credential
+func findPrincipal(client *force.ForceApi, externalCredential, principal string) (string, error) {
+	records, err := queryPrincipals(client,
+		fmt.Sprintf("ExternalCredential.DeveloperName = '%s'", soqlEscape(externalCredential)),
+		fmt.Sprintf("ParameterName = '%s'", soqlEscape(principal)),
+	)
+	if err != nil {
+		return "", err
+	}
+	if len(records) == 0 {
+		return "", fmt.Errorf("external credential %s has no principal %s", externalCredential, principal)
+	}
+	return records[0].Id, nil
+}
+
+func (r *permissionSetPrincipalAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data permissionSetPrincipalAccessResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principalId, err := findPrincipal(r.client, data.ExternalCredential.ValueString(), data.Principal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("principal"), "Error Getting Principal", err.Error())
+		return
+	}
+	sfResp, err := r.client.InsertSObject(dynamicSObject{apiName: "SetupEntityAccess", fields: map[string]interface{}{
+		"ParentId":      data.PermissionSetId.ValueString(),
+		"SetupEntityId": principalId,
+	}})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Inserting Principal Access", err.Error())
+		return
+	}
+	data.Id = types.StringValue(sfResp.Id)
+	data.PrincipalId = types.StringValue(normalizeId(principalId))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSetPrincipalAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data permissionSetPrincipalAccessResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accesses, err := queryAllRecords[setupEntityAccessRecord](r.client, force.BuildQuery(
+		"Id, ParentId, SetupEntityId",
+		"SetupEntityAccess",
+		[]string{fmt.Sprintf("Id = '%s'", soqlEscape(data.Id.ValueString()))},
+	))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Principal Access", err.Error())
+		return
+	}
+	if len(accesses) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	principals, err := queryPrincipals(r.client, fmt.Sprintf("Id = '%s'", soqlEscape(accesses[0].SetupEntityId)))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Principal", err.Error())
+		return
+	}
+	if len(principals) == 0 {
+		// the access was granted to something other than a principal
+		resp.Diagnostics.AddError("Error Getting Principal", fmt.Sprintf("%s doesn't give access to a principal of an external credential.", data.Id.ValueString()))
+		return
+	}
+
+	data.PermissionSetId = sameId(data.PermissionSetId, &accesses[0].ParentId)
+	data.ExternalCredential = types.StringValue(principals[0].ExternalCredential.DeveloperName)
+	data.Principal = types.StringValue(principals[0].ParameterName)
+	data.PrincipalId = types.StringValue(normalizeId(principals[0].Id))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSetPrincipalAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every configurable attribute forces replacement, there is nothing to update
+	var data permissionSetPrincipalAccessResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *permissionSetPrincipalAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data permissionSetPrincipalAccessResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSObject(data.Id.ValueString(), dynamicSObject{apiName: "SetupEntityAccess"}); err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Principal Access", err.Error())
+		return
+	}
+}
+
+func (r *permissionSetPrincipalAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: permission_set_id/External_Credential/Principal. Got: %q", req.ID),
+		)
+		return
+	}
+	permissionSetId, externalCredential, principal := normalizeId(parts[0]), parts[1], parts[2]
+
+	principalId, err := findPrincipal(r.client, externalCredential, principal)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Principal", err.Error())
+		return
+	}
+	accesses, err := queryAllRecords[setupEntityAccessRecord](r.client, force.BuildQuery(
+		"Id, ParentId, SetupEntityId",
+		"SetupEntityAccess",
+		[]string{
+			fmt.Sprintf("ParentId = '%s'", soqlEscape(permissionSetId)),
+			fmt.Sprintf("SetupEntityId = '%s'", soqlEscape(principalId)),
+		},
+	))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Getting Principal Access", err.Error())
+		return
+	}
+	if len(accesses) == 0 {
+		resp.Diagnostics.AddError("Error Getting Principal Access", fmt.Sprintf("Permission set %s has no access to principal %s of %s.", permissionSetId, principal, externalCredential))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accesses[0].Id)...)
+}
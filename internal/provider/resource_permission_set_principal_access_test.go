@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourcePermissionSetPrincipalAccess_basic(t *testing.T) {
+	t.Parallel()
+
+	permissionSetId := os.Getenv("SALESFORCE_PERMISSION_SET_ID")
+	if permissionSetId == "" {
+		t.Skip("SALESFORCE_PERMISSION_SET_ID must be the ID of a permission set that isn't owned by a profile to run this test")
+	}
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionSetPrincipalAccess_basic(name, permissionSetId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("salesforce_permission_set_principal_access.test", "id"),
+					resource.TestCheckResourceAttrSet("salesforce_permission_set_principal_access.test", "principal_id"),
+					resource.TestCheckResourceAttr("salesforce_permission_set_principal_access.test", "principal", "Integration"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_permission_set_principal_access.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					access := s.RootModule().Resources["salesforce_permission_set_principal_access.test"].Primary.Attributes
+					return fmt.Sprintf("%s/%s/%s", access["permission_set_id"], access["external_credential"], access["principal"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourcePermissionSetPrincipalAccess_basic(name, permissionSetId string) string {
+	return fmt.Sprintf(`
+resource "salesforce_external_credential" "test" {
+  name                    = "%s"
+  label                   = "Terraform Test"
+  authentication_protocol = "Custom"
+  principals = [
+    { name = "Integration", type = "NamedPrincipal" },
+  ]
+}
+
+resource "salesforce_permission_set_principal_access" "test" {
+  permission_set_id   = "%s"
+  external_credential = salesforce_external_credential.test.name
+  principal           = "Integration"
+}
+`, name, permissionSetId)
+}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+// recordTypeAttributes maps the fields named by Metadata API errors to attributes
+var recordTypeAttributes = map[string]path.Path{
+	"businessProcess": path.Root("business_process"),
+	"fullName":        path.Root("developer_name"),
+	"label":           path.Root("label"),
+	"picklistValues":  path.Root("picklist_values"),
+}
+
+// businessProcessObjects are the objects whose record types require a business process
+var businessProcessObjects = []string{"Case", "Lead", "Opportunity", "Solution"}
+
+type recordTypeResource struct {
+	client   *force.ForceApi
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                   = &recordTypeResource{}
+	_ resource.ResourceWithValidateConfig = &recordTypeResource{}
+	_ resource.ResourceWithImportState    = &recordTypeResource{}
+)
+
+func (r *recordTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_record_type"
+}
+
+func (r *recordTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Record Type Resource for the Salesforce Provider, managed through the Metadata API. Record types are deactivated before they are deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the full name of the record type in the format Object.DeveloperName.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object": schema.StringAttribute{
+				Description: "API name of the object of the record type, such as Account or Widget__c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"developer_name": schema.StringAttribute{
+				Description: "The unique name of the record type on the object. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_type_id": schema.StringAttribute{
+				Description: "Salesforce ID of the record type, for the record_type_id of records.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the record type.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the record type.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the record type can be assigned to records. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsTrue{},
+				},
+			},
+			"business_process": schema.StringAttribute{
+				Description: "Name of the business process of the record type, required for Case, Lead, Opportunity and Solution record types.",
+				Optional:    true,
+			},
+			"picklist_values": schema.ListNestedAttribute{
+				Description: "Values of picklist fields available to records of the record type. Picklists that aren't listed keep the values assigned to them and aren't imported.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"picklist": schema.StringAttribute{
+							Description: "API name of the picklist field, such as LeadSource or Tier__c.",
+							Required:    true,
+							Validators: []validator.String{
+								notEmptyString{},
+							},
+						},
+						"values": schema.SetAttribute{
+							Description: "Values of the picklist available to the record type.",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+						"default_value": schema.StringAttribute{
+							Description: "Value selected by default on new records of the record type, it must be one of the values.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type recordTypeResourceModel struct {
+	Id              types.String                   `tfsdk:"id"`
+	Object          types.String                   `tfsdk:"object"`
+	DeveloperName   types.String                   `tfsdk:"developer_name"`
+	RecordTypeId    types.String                   `tfsdk:"record_type_id"`
+	Label           types.String                   `tfsdk:"label"`
+	Description     types.String                   `tfsdk:"description"`
+	Active          types.Bool                     `tfsdk:"active"`
+	BusinessProcess types.String                   `tfsdk:"business_process"`
+	PicklistValues  []recordTypePicklistValueModel `tfsdk:"picklist_values"`
+}
+
+type recordTypePicklistValueModel struct {
+	Picklist     types.String   `tfsdk:"picklist"`
+	Values       []types.String `tfsdk:"values"`
+	DefaultValue types.String   `tfsdk:"default_value"`
+}
+
+func (r *recordTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data recordTypeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Object.IsUnknown() && data.BusinessProcess.IsNull() && contains(businessProcessObjects, data.Object.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("business_process"), "Missing Business Process", fmt.Sprintf("Record types of %s require a business process.", data.Object.ValueString()))
+	}
+	picklists := make(map[string]bool)
+	for i, picklist := range data.PicklistValues {
+		if picklist.Picklist.IsUnknown() {
+			continue
+		}
+		if picklists[picklist.Picklist.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("picklist_values").AtListIndex(i).AtName("picklist"), "Duplicate picklist", fmt.Sprintf("%q is listed more than once.", picklist.Picklist.ValueString()))
+		}
+		picklists[picklist.Picklist.ValueString()] = true
+		if picklist.Values == nil || picklist.DefaultValue.IsNull() || picklist.DefaultValue.IsUnknown() {
+			continue
+		}
+		found := false
+		for _, v := range picklist.Values {
+			found = found || v.IsUnknown() || v.ValueString() == picklist.DefaultValue.ValueString()
+		}
+		if !found {
+			resp.Diagnostics.AddAttributeError(path.Root("picklist_values").AtListIndex(i).AtName("default_value"), "Invalid default value", fmt.Sprintf("%q is not one of the values.", picklist.DefaultValue.ValueString()))
+		}
+	}
+}
+
+func expandRecordType(data recordTypeResourceModel) metadata.RecordType {
+	recordType := metadata.RecordType{
+		FullName:        data.Object.ValueString() + "." + data.DeveloperName.ValueString(),
+		Active:          data.Active.ValueBool(),
+		BusinessProcess: data.BusinessProcess.ValueString(),
+		Description:     data.Description.ValueString(),
+		Label:           data.Label.ValueString(),
+	}
+	for _, picklist := range data.PicklistValues {
+		values := metadata.RecordTypePicklistValue{Picklist: picklist.Picklist.ValueString()}
+		for _, v := range picklist.Values {
+			values.Values = append(values.Values, metadata.PicklistValue{
+				FullName: metadata.EncodePicklistValue(v.ValueString()),
+				Default:  v.ValueString() == picklist.DefaultValue.ValueString(),
+			})
+		}
+		recordType.PicklistValues = append(recordType.PicklistValues, values)
+	}
+	return recordType
+}
+
+// flattenRecordType reads the picklists in the prior picklist_values, in their order, the other
+// picklists aren't managed
+func flattenRecordType(data *recordTypeResourceModel, recordType metadata.RecordType) {
+	object, developerName, _ := strings.Cut(recordType.FullName, ".")
+	data.Id = types.StringValue(recordType.FullName)
+	data.Object = types.StringValue(object)
+	data.DeveloperName = types.StringValue(developerName)
+	data.Label = types.StringValue(recordType.Label)
+	data.Description = metadataString(recordType.Description)
+	data.Active = types.BoolValue(recordType.Active)
+	data.BusinessProcess = metadataString(recordType.BusinessProcess)
+	if data.PicklistValues == nil {
+		return
+	}
+
+	current := make(map[string]metadata.RecordTypePicklistValue)
+	for _, picklist := range recordType.PicklistValues {
+		current[picklist.Picklist] = picklist
+	}
+	var values []recordTypePicklistValueModel
+	for _, prior := range data.PicklistValues {
+		name := prior.Picklist.ValueString()
+		picklist := recordTypePicklistValueModel{
+			Picklist:     prior.Picklist,
+			Values:       []types.String{},
+			DefaultValue: types.StringNull(),
+		}
+		for _, v := range current[name].Values {
+			value := metadata.DecodePicklistValue(v.FullName)
+			picklist.Values = append(picklist.Values, types.StringValue(value))
+			if v.Default {
+				picklist.DefaultValue = types.StringValue(value)
+			}
+		}
+		values = append(values, picklist)
+	}
+	data.PicklistValues = values
+}
+
+// readRecordTypeId looks the Salesforce ID of the record type up, ignoring the record types of managed packages
+func (r *recordTypeResource) readRecordTypeId(data *recordTypeResourceModel) error {
+	records, err := queryRecordTypes(r.client, data.Object.ValueString(), data.DeveloperName.ValueString())
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if record.NamespacePrefix == nil {
+			data.RecordTypeId = types.StringValue(record.Id)
+			return nil
+		}
+	}
+	return fmt.Errorf("no Record Type %s on %s", data.DeveloperName.ValueString(), data.Object.ValueString())
+}
+
+func (r *recordTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data recordTypeResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType := expandRecordType(data)
+	if err := r.metadata.Create(ctx, recordType); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating Record Type", err, recordTypeAttributes)...)
+		return
+	}
+	data.Id = types.StringValue(recordType.FullName)
+	// the record type exists now, keep it in state and let Read fill in the ID when it can't be queried
+	if err := r.readRecordTypeId(&data); err != nil {
+		data.RecordTypeId = types.StringNull()
+		resp.Diagnostics.AddWarning("Error Reading Record Type ID", fmt.Sprintf("Unable to read the ID of %s, it will be read on the next refresh: %v", data.Id.ValueString(), err))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *recordTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data recordTypeResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType, found, err := metadata.Read[metadata.RecordType](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Record Type", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenRecordType(&data, recordType)
+	if data.RecordTypeId.IsNull() {
+		if err := r.readRecordTypeId(&data); err != nil {
+			resp.Diagnostics.AddError("Error Reading Record Type ID", err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *recordTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data recordTypeResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Update(ctx, expandRecordType(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating Record Type", err, recordTypeAttributes)...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *recordTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data recordTypeResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// active record types can't be deleted
+	recordType, found, err := metadata.Read[metadata.RecordType](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Record Type", err.Error())
+		return
+	}
+	if !found {
+		return
+	}
+	if recordType.Active {
+		recordType.Active = false
+		if err := r.metadata.Update(ctx, recordType); err != nil {
+			resp.Diagnostics.AddError("Error Deactivating Record Type", err.Error())
+			return
+		}
+	}
+	if err := r.metadata.Delete(ctx, "RecordType", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Record Type", err.Error())
+		return
+	}
+}
+
+func (r *recordTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	object, developerName, ok := strings.Cut(req.ID, ".")
+	if !ok || object == "" || checkCustomName(developerName, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Object.DeveloperName. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func TestExpandRecordType(t *testing.T) {
+	t.Parallel()
+
+	data := recordTypeResourceModel{
+		Object:          types.StringValue("Contact"),
+		DeveloperName:   types.StringValue("Partner"),
+		Label:           types.StringValue("Partner"),
+		Description:     types.StringNull(),
+		Active:          types.BoolValue(true),
+		BusinessProcess: types.StringNull(),
+		PicklistValues: []recordTypePicklistValueModel{
+			{
+				Picklist:     types.StringValue("Salutation"),
+				Values:       []types.String{types.StringValue("Mr."), types.StringValue("Ms.")},
+				DefaultValue: types.StringValue("Ms."),
+			},
+		},
+	}
+	want := metadata.RecordType{
+		FullName: "Contact.Partner",
+		Active:   true,
+		Label:    "Partner",
+		PicklistValues: []metadata.RecordTypePicklistValue{
+			{
+				Picklist: "Salutation",
+				Values:   []metadata.PicklistValue{{FullName: "Mr%2E"}, {FullName: "Ms%2E", Default: true}},
+			},
+		},
+	}
+	if got := expandRecordType(data); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestFlattenRecordType(t *testing.T) {
+	t.Parallel()
+
+	recordType := metadata.RecordType{
+		FullName:        "Lead.Partner",
+		Active:          true,
+		BusinessProcess: "Partner Leads",
+		Label:           "Partner",
+		PicklistValues: []metadata.RecordTypePicklistValue{
+			{Picklist: "Industry", Values: []metadata.PicklistValue{{FullName: "Energy"}}},
+			{Picklist: "Salutation", Values: []metadata.PicklistValue{{FullName: "Mr%2E", Default: true}}},
+		},
+	}
+
+	// picklists that aren't configured aren't read
+	var imported recordTypeResourceModel
+	flattenRecordType(&imported, recordType)
+	if imported.PicklistValues != nil || imported.Object.ValueString() != "Lead" || imported.DeveloperName.ValueString() != "Partner" {
+		t.Errorf("unexpected import %+v", imported)
+	}
+
+	data := recordTypeResourceModel{
+		PicklistValues: []recordTypePicklistValueModel{{Picklist: types.StringValue("Salutation")}},
+	}
+	flattenRecordType(&data, recordType)
+	want := []recordTypePicklistValueModel{
+		{Picklist: types.StringValue("Salutation"), Values: []types.String{types.StringValue("Mr.")}, DefaultValue: types.StringValue("Mr.")},
+	}
+	if !reflect.DeepEqual(data.PicklistValues, want) {
+		t.Errorf("expected %+v, got %+v", want, data.PicklistValues)
+	}
+	if data.BusinessProcess.ValueString() != "Partner Leads" || !data.Description.IsNull() {
+		t.Errorf("unexpected record type %+v", data)
+	}
+}
+
+func TestAccResourceRecordType_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRecordType_basic(name, `["Red", "Dark_Blue"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_record_type.test", "id", name+"__c.Standard"),
+					resource.TestCheckResourceAttr("salesforce_record_type.test", "active", "true"),
+					resource.TestCheckResourceAttr("salesforce_record_type.test", "picklist_values.0.values.#", "2"),
+					resource.TestCheckResourceAttrPair("salesforce_record_type.test", "record_type_id", "data.salesforce_record_type.test", "id"),
+				),
+			},
+			{
+				ResourceName:            "salesforce_record_type.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"picklist_values"},
+			},
+			{
+				Config: testAccResourceRecordType_basic(name, `["Red"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_record_type.test", "picklist_values.0.values.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceRecordType_basic(name, colors string) string {
+	return fmt.Sprintf(`
+resource "salesforce_custom_object" "test" {
+  api_name     = "%s__c"
+  label        = "Widget"
+  plural_label = "Widgets"
+  name_field = {
+    label = "Widget Name"
+  }
+}
+
+resource "salesforce_custom_field" "picklist" {
+  object   = salesforce_custom_object.test.api_name
+  api_name = "Color__c"
+  label    = "Color"
+  type     = "Picklist"
+  value_set = {
+    values = [
+      { value = "Red" },
+      { value = "Dark_Blue", label = "Dark Blue" },
+    ]
+  }
+}
+
+resource "salesforce_record_type" "test" {
+  object         = salesforce_custom_object.test.api_name
+  developer_name = "Standard"
+  label          = "Standard"
+  description    = "Managed by Terraform"
+  picklist_values = [
+    {
+      picklist      = salesforce_custom_field.picklist.api_name
+      values        = %s
+      default_value = "Red"
+    },
+  ]
+}
+
+data "salesforce_record_type" "test" {
+  sobject_type   = salesforce_record_type.test.object
+  developer_name = salesforce_record_type.test.developer_name
+}
+`, name, colors)
+}
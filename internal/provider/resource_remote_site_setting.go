@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+// remoteSiteSettingAttributes maps the fields named by Metadata API errors to attributes
+var remoteSiteSettingAttributes = map[string]path.Path{
+	"fullName": path.Root("name"),
+	"url":      path.Root("url"),
+}
+
+type remoteSiteSettingResource struct {
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                = &remoteSiteSettingResource{}
+	_ resource.ResourceWithImportState = &remoteSiteSettingResource{}
+)
+
+func (r *remoteSiteSettingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_remote_site_setting"
+}
+
+func (r *remoteSiteSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Remote Site Setting Resource for the Salesforce Provider, allows Apex callouts to a site, managed through the Metadata API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the same as name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the remote site. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the site, a scheme and host such as https://api.example.com, optionally with a port.",
+				Required:    true,
+				Validators: []validator.String{
+					siteURL{schemes: []string{"https", "http"}},
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether callouts to the site are allowed. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsTrue{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the remote site.",
+				Optional:    true,
+			},
+			"disable_protocol_security": schema.BoolAttribute{
+				Description: "Whether callouts may switch between HTTP and HTTPS, for example an https url with callouts to http. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+		},
+	}
+}
+
+type remoteSiteSettingResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Url                     types.String `tfsdk:"url"`
+	Active                  types.Bool   `tfsdk:"active"`
+	Description             types.String `tfsdk:"description"`
+	DisableProtocolSecurity types.Bool   `tfsdk:"disable_protocol_security"`
+}
+
+func expandRemoteSiteSetting(data remoteSiteSettingResourceModel) metadata.RemoteSiteSetting {
+	return metadata.RemoteSiteSetting{
+		FullName:                data.Name.ValueString(),
+		Description:             data.Description.ValueString(),
+		DisableProtocolSecurity: data.DisableProtocolSecurity.ValueBool(),
+		IsActive:                data.Active.ValueBool(),
+		Url:                     data.Url.ValueString(),
+	}
+}
+
+func (r *remoteSiteSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data remoteSiteSettingResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Create(ctx, expandRemoteSiteSetting(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating Remote Site Setting", err, remoteSiteSettingAttributes)...)
+		return
+	}
+	data.Id = data.Name
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *remoteSiteSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data remoteSiteSettingResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, found, err := metadata.Read[metadata.RemoteSiteSetting](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Remote Site Setting", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(site.FullName)
+	data.Name = types.StringValue(site.FullName)
+	data.Url = types.StringValue(site.Url)
+	data.Active = types.BoolValue(site.IsActive)
+	data.Description = metadataString(site.Description)
+	data.DisableProtocolSecurity = types.BoolValue(site.DisableProtocolSecurity)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *remoteSiteSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data remoteSiteSettingResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Update(ctx, expandRemoteSiteSetting(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating Remote Site Setting", err, remoteSiteSettingAttributes)...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *remoteSiteSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data remoteSiteSettingResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "RemoteSiteSetting", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Remote Site Setting", err.Error())
+		return
+	}
+}
+
+func (r *remoteSiteSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if checkCustomName(req.ID, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Remote_Site_Name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
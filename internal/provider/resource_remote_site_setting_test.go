@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceRemoteSiteSetting_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRemoteSiteSetting_basic(name, "https://api.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_remote_site_setting.test", "id", name),
+					resource.TestCheckResourceAttr("salesforce_remote_site_setting.test", "active", "true"),
+					resource.TestCheckResourceAttr("salesforce_remote_site_setting.test", "disable_protocol_security", "false"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_remote_site_setting.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceRemoteSiteSetting_basic(name, "https://api2.example.com:8443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_remote_site_setting.test", "url", "https://api2.example.com:8443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceRemoteSiteSetting_invalidUrl(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRemoteSiteSetting_basic("tf_test_invalid", "https://api.example.com/v1"),
+				ExpectError: regexp.MustCompile("must only have a scheme, a host and optionally a port"),
+			},
+		},
+	})
+}
+
+func testAccResourceRemoteSiteSetting_basic(name, url string) string {
+	return fmt.Sprintf(`
+resource "salesforce_remote_site_setting" "test" {
+  name        = "%s"
+  url         = "%s"
+  description = "Managed by Terraform"
+}
+`, name, url)
+}
@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+type sobjectResource struct {
+	client *force.ForceApi
+}
+
+var (
+	_ resource.Resource                   = &sobjectResource{}
+	_ resource.ResourceWithModifyPlan     = &sobjectResource{}
+	_ resource.ResourceWithImportState    = &sobjectResource{}
+	_ resource.ResourceWithValidateConfig = &sobjectResource{}
+)
+
+func (r *sobjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_sobject"
+}
+
+func (r *sobjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generic SObject Resource for the Salesforce Provider. Manages a single record of any standard or custom object. Field names, types, createable/updateable flags and required fields are validated against the object's describe metadata at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Description: "API name of the object, for example Contact or Invoice__c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id_field": schema.StringAttribute{
+				Description: "API name of an external ID field. When set with external_id, create upserts the record by that value, adopting a matching record created outside of Terraform instead of inserting a duplicate. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Description: "Value of external_id_field identifying the record. Forces replacement if updated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fields": schema.DynamicAttribute{
+				Description: "Object of field API names to values. Values must match the describe type of the field: strings for text, picklist, reference, date (YYYY-MM-DD) and datetime (RFC 3339) fields, numbers for number, currency and percent fields and bools for checkbox fields. Only fields present in config are read back, removing a field stops managing it without clearing its value. Changing a field that is createable but not updateable forces replacement.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+type sobjectResourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	ObjectType      types.String  `tfsdk:"object_type"`
+	ExternalIdField types.String  `tfsdk:"external_id_field"`
+	ExternalId      types.String  `tfsdk:"external_id"`
+	Fields          types.Dynamic `tfsdk:"fields"`
+}
+
+func (r *sobjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data sobjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ExternalId.IsNull() != data.ExternalIdField.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("external_id_field"), "Invalid Attribute Combination", "external_id and external_id_field must be set together.")
+	}
+}
+
+func (r *sobjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan sobjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ObjectType.IsUnknown() || plan.Fields.IsUnknown() || plan.Fields.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	desc, err := describeSObject(r.client, plan.ObjectType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("object_type"), "Invalid object type", err.Error())
+		return
+	}
+	describeFields := sobjectFieldsByName(desc)
+
+	if !plan.ExternalIdField.IsNull() && !plan.ExternalIdField.IsUnknown() {
+		field, ok := describeFields[strings.ToLower(plan.ExternalIdField.ValueString())]
+		if !ok || !(field.ExternalId || field.IdLookup) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("external_id_field"),
+				"Invalid external ID field",
+				fmt.Sprintf("%s has no external ID field named %s", desc.Name, plan.ExternalIdField.ValueString()),
+			)
+		}
+	}
+
+	planFields, err := dynamicElements(ctx, plan.Fields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields", err.Error())
+		return
+	}
+
+	var stateFields map[string]attr.Value
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		var state sobjectResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateFields, _ = dynamicElements(ctx, state.Fields)
+	}
+
+	requiresReplace := false
+	for name, value := range planFields {
+		field, ok := describeFields[strings.ToLower(name)]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fields"),
+				"Invalid field",
+				fmt.Sprintf("%s has no field named %s", desc.Name, name),
+			)
+			continue
+		}
+		if err := checkSObjectFieldValue(field, value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid field value", err.Error())
+			continue
+		}
+
+		if creating {
+			if !field.Createable {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("fields"),
+					"Invalid field",
+					fmt.Sprintf("Field %s on %s is not createable", field.Name, desc.Name),
+				)
+			}
+			continue
+		}
+		if prior, ok := stateFields[name]; ok && prior.Equal(value) {
+			continue
+		}
+		if value.IsUnknown() && !field.Updateable {
+			// can't tell until apply whether the value changes
+			requiresReplace = requiresReplace || field.Createable
+			continue
+		}
+		if !field.Updateable {
+			if !field.Createable {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("fields"),
+					"Invalid field",
+					fmt.Sprintf("Field %s on %s is neither createable nor updateable", field.Name, desc.Name),
+				)
+				continue
+			}
+			requiresReplace = true
+		}
+	}
+
+	if creating || requiresReplace {
+		var missing []string
+		for _, field := range requiredSObjectFields(desc) {
+			found := false
+			for name := range planFields {
+				if strings.EqualFold(name, field.Name) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = append(missing, field.Name)
+			}
+		}
+		if len(missing) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fields"),
+				"Missing required fields",
+				fmt.Sprintf("%s requires values for: [%s]", desc.Name, strings.Join(missing, ", ")),
+			)
+		}
+	}
+
+	if requiresReplace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("fields"))
+	}
+}
+
+func (r *sobjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data sobjectResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elements, err := dynamicElements(ctx, data.Fields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields", err.Error())
+		return
+	}
+	sobject := dynamicSObject{
+		apiName: data.ObjectType.ValueString(),
+		fields:  make(map[string]interface{}),
+	}
+	for name, value := range elements {
+		if value.IsNull() {
+			continue
+		}
+		v, err := sobjectValueToInterface(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid field value", fmt.Sprintf("%s: %s", name, err))
+			return
+		}
+		sobject.fields[name] = v
+	}
+
+	if !data.ExternalId.IsNull() {
+		// the external ID value is taken from the url, don't send a possibly conflicting copy in the body
+		sobject.externalIdApiName = data.ExternalIdField.ValueString()
+		for name := range sobject.fields {
+			if strings.EqualFold(name, sobject.externalIdApiName) {
+				delete(sobject.fields, name)
+			}
+		}
+		id, err := upsertSObject(r.client, sobject, data.ExternalId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Upserting %s", data.ObjectType.ValueString()), err.Error())
+			return
+		}
+		data.Id = types.StringValue(id)
+	} else {
+		sfResp, err := r.client.InsertSObject(sobject)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Inserting %s", data.ObjectType.ValueString()), err.Error())
+			return
+		}
+		data.Id = types.StringValue(sfResp.Id)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *sobjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data sobjectResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectType := data.ObjectType.ValueString()
+	desc, err := describeSObject(r.client, objectType)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Describing %s", objectType), err.Error())
+		return
+	}
+	describeFields := sobjectFieldsByName(desc)
+
+	elements, err := dynamicElements(ctx, data.Fields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields", err.Error())
+		return
+	}
+	// only read the fields under management, always request Id so the record's existence is checked
+	fieldNames := []string{"Id"}
+	for name := range elements {
+		fieldNames = append(fieldNames, name)
+	}
+
+	sobject := dynamicSObject{apiName: objectType}
+	if err := r.client.GetSObject(data.Id.ValueString(), fieldNames, &sobject); err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", objectType), err.Error())
+		return
+	}
+
+	if len(elements) > 0 {
+		read := make(map[string]attr.Value, len(elements))
+		for name, prior := range elements {
+			raw, _ := lookupSObjectField(sobject.fields, name)
+			v, err := sobjectValueFromInterface(raw, prior, describeFields[strings.ToLower(name)])
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("fields"), "Error reading field", fmt.Sprintf("%s: %s", name, err))
+				return
+			}
+			read[name] = v
+		}
+		data.Fields, err = dynamicWithElements(ctx, data.Fields, read)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fields"), "Error reading fields", err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *sobjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state sobjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elements, err := dynamicElements(ctx, data.Fields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields", err.Error())
+		return
+	}
+	stateElements, _ := dynamicElements(ctx, state.Fields)
+
+	// only send changed fields so non-updateable fields that were not touched don't error
+	sobject := dynamicSObject{
+		apiName: data.ObjectType.ValueString(),
+		fields:  make(map[string]interface{}),
+	}
+	for name, value := range elements {
+		if prior, ok := stateElements[name]; ok && prior.Equal(value) {
+			continue
+		}
+		v, err := sobjectValueToInterface(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid field value", fmt.Sprintf("%s: %s", name, err))
+			return
+		}
+		sobject.fields[name] = v
+	}
+
+	if len(sobject.fields) > 0 {
+		if err := r.client.UpdateSObject(data.Id.ValueString(), sobject); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Updating %s", data.ObjectType.ValueString()), err.Error())
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *sobjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data sobjectResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the client panics on object types it didn't list at login, such as ones deleted or renamed since
+	objectType := data.ObjectType.ValueString()
+	if _, err := describeSObject(r.client, objectType); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Describing %s", objectType), err.Error())
+		return
+	}
+	if err := r.client.DeleteSObject(data.Id.ValueString(), dynamicSObject{apiName: objectType}); err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error Deleting %s", objectType), err.Error())
+		return
+	}
+}
+
+func (r *sobjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	for _, part := range parts {
+		if part == "" {
+			parts = nil
+		}
+	}
+	if len(parts) < 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: object_type/id or object_type/external_id_field/external_id. Got: %q", req.ID),
+		)
+		return
+	}
+	objectType := parts[0]
+	if _, err := describeSObject(r.client, objectType); err != nil {
+		resp.Diagnostics.AddError("Invalid object type", err.Error())
+		return
+	}
+
+	id := normalizeId(parts[1])
+	if len(parts) == 3 {
+		externalIdField, externalId := parts[1], parts[2]
+		existing := dynamicSObject{apiName: objectType, externalIdApiName: externalIdField}
+		if err := r.client.GetSObjectByExternalId(url.PathEscape(externalId), []string{"Id"}, &existing); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error Getting %s", objectType), err.Error())
+			return
+		}
+		raw, _ := lookupSObjectField(existing.fields, "Id")
+		id, _ = raw.(string)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external_id_field"), externalIdField)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external_id"), externalId)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), objectType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fields"), types.DynamicNull())...)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourceSObject_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSObject_basic(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("salesforce_sobject.test", "id"),
+					resource.TestCheckResourceAttr("salesforce_sobject.test", "fields.Name", name),
+					resource.TestCheckResourceAttr("salesforce_sobject.test", "fields.NumberOfEmployees", "10"),
+				),
+			},
+			{
+				ResourceName:            "salesforce_sobject.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSObjectImportStateIdFunc("salesforce_sobject.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields"},
+			},
+			{
+				Config: testAccResourceSObject_basic(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_sobject.test", "fields.NumberOfEmployees", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSObject_invalidField(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSObject_invalidField(),
+				ExpectError: regexp.MustCompile("Account has no field named NotAField__c"),
+			},
+		},
+	})
+}
+
+func testAccSObjectImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["object_type"], rs.Primary.ID), nil
+	}
+}
+
+func testAccResourceSObject_basic(name string, employees int) string {
+	return fmt.Sprintf(`
+resource "salesforce_sobject" "test" {
+  object_type = "Account"
+  fields = {
+    Name              = "%s"
+    NumberOfEmployees = %d
+  }
+}
+`, name, employees)
+}
+
+func testAccResourceSObject_invalidField() string {
+	return `
+resource "salesforce_sobject" "test" {
+  object_type = "Account"
+  fields = {
+    Name         = "invalid"
+    NotAField__c = "value"
+  }
+}
+`
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+// standardValueSetFields lists the standard picklist fields of the common standard value sets,
+// checked for records still using removed values
+var standardValueSetFields = map[string][]string{
+	"AccountOwnership": {"Account.Ownership"},
+	"AccountRating":    {"Account.Rating"},
+	"AccountType":      {"Account.Type"},
+	"CaseOrigin":       {"Case.Origin"},
+	"CasePriority":     {"Case.Priority"},
+	"CaseReason":       {"Case.Reason"},
+	"CaseStatus":       {"Case.Status"},
+	"CaseType":         {"Case.Type"},
+	"Industry":         {"Account.Industry", "Lead.Industry"},
+	"LeadSource":       {"Contact.LeadSource", "Lead.LeadSource", "Opportunity.LeadSource"},
+	"LeadStatus":       {"Lead.Status"},
+	"OpportunityStage": {"Opportunity.StageName"},
+	"OpportunityType":  {"Opportunity.Type"},
+	"Salutation":       {"Contact.Salutation", "Lead.Salutation"},
+	"TaskPriority":     {"Task.Priority"},
+	"TaskStatus":       {"Task.Status"},
+}
+
+type standardValueSetResource struct {
+	client   *force.ForceApi
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                   = &standardValueSetResource{}
+	_ resource.ResourceWithValidateConfig = &standardValueSetResource{}
+	_ resource.ResourceWithModifyPlan     = &standardValueSetResource{}
+	_ resource.ResourceWithImportState    = &standardValueSetResource{}
+)
+
+func (r *standardValueSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_standard_value_set"
+}
+
+func (r *standardValueSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := valueSetAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the resource, the name of the value set.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the standard value set, such as Industry or LeadSource. Forces replacement if updated.",
+		Required:    true,
+		Validators: []validator.String{
+			notEmptyString{},
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Standard Value Set Resource for the Salesforce Provider, the values of a standard picklist such as Industry. Standard value sets always exist: creating the resource takes over the values of the set and destroying it only removes it from the state, leaving the values as they are.",
+		Attributes:  attributes,
+	}
+}
+
+type standardValueSetResourceModel struct {
+	Id             types.String         `tfsdk:"id"`
+	Name           types.String         `tfsdk:"name"`
+	Values         []valueSetValueModel `tfsdk:"values"`
+	DefaultValue   types.String         `tfsdk:"default_value"`
+	Sorted         types.Bool           `tfsdk:"sorted"`
+	InactiveValues types.List           `tfsdk:"inactive_values"`
+}
+
+// standardCustomValues returns the values without the settings specific to standard values
+func standardCustomValues(values []metadata.StandardValue) []metadata.CustomValue {
+	out := make([]metadata.CustomValue, len(values))
+	for i, v := range values {
+		out[i] = v.CustomValue
+	}
+	return out
+}
+
+// expandStandardValueSet keeps the settings of the current values, such as the probability of
+// opportunity stages, which can't be configured
+func expandStandardValueSet(data standardValueSetResourceModel, current []metadata.StandardValue) metadata.StandardValueSet {
+	currentValues := make(map[string]metadata.StandardValue)
+	for _, v := range current {
+		currentValues[v.FullName] = v
+	}
+	set := metadata.StandardValueSet{
+		FullName: data.Name.ValueString(),
+		Sorted:   data.Sorted.ValueBool(),
+	}
+	for _, v := range expandValueSetValues(data.Values, data.DefaultValue, standardCustomValues(current)) {
+		value := currentValues[v.FullName]
+		value.CustomValue = v
+		set.StandardValues = append(set.StandardValues, value)
+	}
+	return set
+}
+
+func (r *standardValueSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data standardValueSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateValueSetValues(data.Values, data.DefaultValue)...)
+}
+
+func (r *standardValueSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state standardValueSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	fields := standardValueSetFields[plan.Name.ValueString()]
+	if resp.Diagnostics.HasError() || r.metadata == nil || len(fields) == 0 {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		// creating takes over the set, the current values that aren't configured are deactivated
+		current, found, err := metadata.Read[metadata.StandardValueSet](ctx, r.metadata, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("values"),
+				"Unable to check value usage",
+				fmt.Sprintf("Couldn't read the current values of %s, records that still have the values deactivated by taking over the set weren't checked: %s", plan.Name.ValueString(), err),
+			)
+			return
+		}
+		if !found {
+			return
+		}
+		state.Values, _, _ = flattenValueSetValues(nil, standardCustomValues(current.StandardValues))
+	}
+	resp.Diagnostics.Append(valueSetUsageWarnings(r.client, fields, removedValueSetValues(state.Values, plan.Values))...)
+}
+
+// update writes the planned values over the current ones, standard value sets can't be created
+func (r *standardValueSetResource) update(ctx context.Context, data *standardValueSetResourceModel) error {
+	current, found, err := metadata.Read[metadata.StandardValueSet](ctx, r.metadata, data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("standard value set %s not found", data.Name.ValueString())
+	}
+	set := expandStandardValueSet(*data, current.StandardValues)
+	if err := r.metadata.Update(ctx, set); err != nil {
+		return err
+	}
+	data.Id = data.Name
+	data.InactiveValues = inactiveValueSetValues(data.Values, standardCustomValues(set.StandardValues))
+	return nil
+}
+
+func (r *standardValueSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data standardValueSetResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error Creating Standard Value Set", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *standardValueSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data standardValueSetResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, found, err := metadata.Read[metadata.StandardValueSet](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Standard Value Set", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(set.FullName)
+	data.Name = types.StringValue(set.FullName)
+	data.Sorted = types.BoolValue(set.Sorted)
+	values, inactive, defaultValue := flattenValueSetValues(data.Values, standardCustomValues(set.StandardValues))
+	data.Values = values
+	data.DefaultValue = defaultValue
+	data.InactiveValues, diags = types.ListValueFrom(ctx, types.StringType, inactive)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *standardValueSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data standardValueSetResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error Updating Standard Value Set", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *standardValueSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// standard value sets can't be deleted, the values are left as they are
+	resp.Diagnostics.AddWarning(
+		"Standard Value Set Not Deleted",
+		"Standard value sets can't be deleted, the resource was removed from the state and the values were left unchanged.",
+	)
+}
+
+func (r *standardValueSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func TestExpandStandardValueSet(t *testing.T) {
+	t.Parallel()
+
+	probability := int64(10)
+	current := []metadata.StandardValue{
+		{
+			CustomValue:      metadata.CustomValue{FullName: "Prospecting", Label: "Prospecting", Default: true},
+			Closed:           boolPointer(false),
+			ForecastCategory: "Pipeline",
+			Probability:      &probability,
+		},
+	}
+	data := standardValueSetResourceModel{
+		Name:         types.StringValue("OpportunityStage"),
+		Values:       []valueSetValueModel{testValueSetValue("Prospecting"), testValueSetValue("Discovery")},
+		DefaultValue: types.StringNull(),
+		Sorted:       types.BoolValue(false),
+	}
+	set := expandStandardValueSet(data, current)
+	want := []metadata.StandardValue{
+		{
+			CustomValue:      metadata.CustomValue{FullName: "Prospecting", Label: "Prospecting", IsActive: boolPointer(true)},
+			Closed:           boolPointer(false),
+			ForecastCategory: "Pipeline",
+			Probability:      &probability,
+		},
+		{CustomValue: metadata.CustomValue{FullName: "Discovery", Label: "Discovery", IsActive: boolPointer(true)}},
+	}
+	if set.FullName != "OpportunityStage" || !reflect.DeepEqual(set.StandardValues, want) {
+		t.Errorf("expected %+v, got %+v", want, set.StandardValues)
+	}
+}
+
+func TestAccResourceStandardValueSet_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStandardValueSet_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_standard_value_set.test", "id", "AccountRating"),
+					resource.TestCheckResourceAttr("salesforce_standard_value_set.test", "values.#", "3"),
+					resource.TestCheckResourceAttr("salesforce_standard_value_set.test", "default_value", "Warm"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_standard_value_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceStandardValueSet_basic = `
+resource "salesforce_standard_value_set" "test" {
+  name          = "AccountRating"
+  default_value = "Warm"
+  values = [
+    { value = "Hot" },
+    { value = "Warm" },
+    { value = "Cold" },
+  ]
+}
+`
@@ -5,30 +5,39 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/nimajalali/go-force/force"
-	"github.com/nimajalali/go-force/sobjects"
 )
 
+// keyed by the attribute path, as planmodifier requests report it
 var userDefaults = resourceDefaults{
 	defaults: map[string]attr.Value{
-		tftypes.NewAttributePath().WithAttributeName("email_encoding_key").String(): types.StringValue("UTF-8"),
-		tftypes.NewAttributePath().WithAttributeName("language_locale_key").String(): types.StringValue("en_US"),
-		tftypes.NewAttributePath().WithAttributeName("locale_sid_key").String(): types.StringValue("en_US"),
-		tftypes.NewAttributePath().WithAttributeName("time_zone_sid_key").String(): types.StringValue("America/New_York"),
+		path.Root("email_encoding_key").String():  types.StringValue("UTF-8"),
+		path.Root("language_locale_key").String(): types.StringValue("en_US"),
+		path.Root("locale_sid_key").String():      types.StringValue("en_US"),
+		path.Root("time_zone_sid_key").String():   types.StringValue("America/New_York"),
 	},
 }
 
 type userResource struct {
-	client *force.ForceApi
+	client    *force.ForceApi
+	picklists *picklistCache
 }
 
-var _ resource.Resource = &userResource{}
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
 
 func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "salesforce_user"
@@ -54,11 +63,23 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "The email encoding for the user, such as ISO-8859-1 or UTF-8. Defaults to UTF-8.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					userDefaults,
+				},
+				Validators: []validator.String{
+					userPicklist{cache: r.picklists, field: "EmailEncodingKey"},
+				},
 			},
 			"language_locale_key": schema.StringAttribute{
 				Description: "The user's language. Defaults to en_US.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					userDefaults,
+				},
+				Validators: []validator.String{
+					userPicklist{cache: r.picklists, field: "LanguageLocaleKey"},
+				},
 			},
 			"last_name": schema.StringAttribute{
 				Description: "The user's last name.",
@@ -68,6 +89,12 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "The value of the field affects formatting and parsing of values, especially numeric values, in the user interface. It doesn't affect the API. The field values are named according to the language, and the country if necessary, using two-letter ISO codes. The set of names is based on the ISO standard. You can also manually set a user's locale in the user interface, and then use that value for inserting or updating other users via the API. Defaults to en_US.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					userDefaults,
+				},
+				Validators: []validator.String{
+					userPicklist{cache: r.picklists, field: "LocaleSidKey"},
+				},
 			},
 			"profile_id": schema.StringAttribute{
 				Description: "ID of the user's Profile. Use this value to cache metadata based on profile.",
@@ -77,6 +104,12 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "A User time zone affects the offset used when displaying or entering times in the user interface. But the API doesn't use a User time zone when querying or setting values. Values for this field are named using region and key city, according to ISO standards. You can also manually set one User time zone in the user interface, and then use that value for creating or updating other User records via the API. Defaults to America/New_York.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					userDefaults,
+				},
+				Validators: []validator.String{
+					userPicklist{cache: r.picklists, field: "TimeZoneSidKey"},
+				},
 			},
 			"username": schema.StringAttribute{
 				Description: "Contains the name that a user enters to log in to the API or the user interface. The value for this field must be in the form of an email address, using all lowercase characters. It must also be unique across all organizations. If you try to create or update a User with a duplicate value for this field, the operation is rejected. Each inserted User also counts as a license. Every organization has a maximum number of licenses. If you attempt to exceed the maximum number of licenses by inserting User records, the create request is rejected.",
@@ -90,30 +123,157 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "Reset password and send an email to the user. No reset is performed if this field is omitted, is false, or was true and remained true on subsequent apply. Please set to false and then true in subsequent applies, or have it set to true on create to trigger the reset.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsFalse{},
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Description: "The user's first name.",
+				Optional:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The user's business title, such as Vice President.",
+				Optional:    true,
+			},
+			"department": schema.StringAttribute{
+				Description: "The company department associated with the user.",
+				Optional:    true,
+			},
+			"company_name": schema.StringAttribute{
+				Description: "The name of the user's company.",
+				Optional:    true,
+			},
+			"manager_id": schema.StringAttribute{
+				Description: "ID of the user who manages this user.",
+				Optional:    true,
+			},
+			"federation_identifier": schema.StringAttribute{
+				Description: "The value used to identify the user for SAML single sign-on, matched against the subject or attribute of the assertion. Must be unique in the org.",
+				Optional:    true,
 			},
+			"phone": schema.StringAttribute{
+				Description: "The user's phone number.",
+				Optional:    true,
+			},
+			"mobile_phone": schema.StringAttribute{
+				Description: "The user's mobile phone number.",
+				Optional:    true,
+			},
+			"employee_number": schema.StringAttribute{
+				Description: "The user's employee number.",
+				Optional:    true,
+			},
+			"community_nickname": schema.StringAttribute{
+				Description: "Name used to identify the user in Experience Cloud sites and Chatter. Generated from the user's name if not set, and must be unique in the org.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.SingleNestedAttribute{
+				Description: "The user's address.",
+				Optional:    true,
+				Attributes:  addressAttributes("user's"),
+			},
+			"is_frozen": schema.BoolAttribute{
+				Description: "Whether the user is frozen, blocking them from logging in without deactivating them. Managed through the user's UserLogin record and read back on refresh once set, so freezing from the Salesforce UI shows up as drift. Left unset, the UserLogin record isn't read and freezing isn't tracked.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_permissions_marketing_user":   userPermissionAttribute("Marketing User"),
+			"user_permissions_knowledge_user":   userPermissionAttribute("Knowledge User"),
+			"user_permissions_offline_user":     userPermissionAttribute("Offline User"),
+			"user_permissions_sf_content_user":  userPermissionAttribute("Salesforce CRM Content User"),
+			"user_permissions_support_user":     userPermissionAttribute("Service Cloud User"),
+			"user_permissions_interaction_user": userPermissionAttribute("Flow User"),
+		},
+	}
+}
+
+// userPermissionAttribute is a feature license checkbox on User, the field only exists when the feature is enabled in the org
+func userPermissionAttribute(license string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Whether the user has the %s feature license. Defaults to false.", license),
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
 		},
 	}
 }
 
 type userResourceModel struct {
-	Id                types.String `tfsdk:"id"`
-	Alias             types.String `tfsdk:"alias"`
-	Email             types.String `tfsdk:"email"`
-	EmailEncodingKey  types.String `tfsdk:"email_encoding_key"`
-	LanguageLocaleKey types.String `tfsdk:"language_locale_key"`
-	LastName          types.String `tfsdk:"last_name"`
-	LocaleSidKey      types.String `tfsdk:"locale_sid_key"`
-	ProfileID         types.String `tfsdk:"profile_id"`
-	TimeZoneSidKey    types.String `tfsdk:"time_zone_sid_key"`
-	Username          types.String `tfsdk:"username"`
-	UserRoleId        types.String `tfsdk:"user_role_id"`
-	ResetPassword     types.Bool   `tfsdk:"reset_password"`
-}
-
-// Custom User struct that includes UserRoleId
+	Id                             types.String  `tfsdk:"id"`
+	Alias                          types.String  `tfsdk:"alias"`
+	Email                          types.String  `tfsdk:"email"`
+	EmailEncodingKey               types.String  `tfsdk:"email_encoding_key"`
+	LanguageLocaleKey              types.String  `tfsdk:"language_locale_key"`
+	LastName                       types.String  `tfsdk:"last_name"`
+	LocaleSidKey                   types.String  `tfsdk:"locale_sid_key"`
+	ProfileID                      types.String  `tfsdk:"profile_id"`
+	TimeZoneSidKey                 types.String  `tfsdk:"time_zone_sid_key"`
+	Username                       types.String  `tfsdk:"username"`
+	UserRoleId                     types.String  `tfsdk:"user_role_id"`
+	ResetPassword                  types.Bool    `tfsdk:"reset_password"`
+	FirstName                      types.String  `tfsdk:"first_name"`
+	Title                          types.String  `tfsdk:"title"`
+	Department                     types.String  `tfsdk:"department"`
+	CompanyName                    types.String  `tfsdk:"company_name"`
+	ManagerId                      types.String  `tfsdk:"manager_id"`
+	FederationIdentifier           types.String  `tfsdk:"federation_identifier"`
+	Phone                          types.String  `tfsdk:"phone"`
+	MobilePhone                    types.String  `tfsdk:"mobile_phone"`
+	EmployeeNumber                 types.String  `tfsdk:"employee_number"`
+	CommunityNickname              types.String  `tfsdk:"community_nickname"`
+	Address                        *addressModel `tfsdk:"address"`
+	IsFrozen                       types.Bool    `tfsdk:"is_frozen"`
+	UserPermissionsMarketingUser   types.Bool    `tfsdk:"user_permissions_marketing_user"`
+	UserPermissionsKnowledgeUser   types.Bool    `tfsdk:"user_permissions_knowledge_user"`
+	UserPermissionsOfflineUser     types.Bool    `tfsdk:"user_permissions_offline_user"`
+	UserPermissionsSFContentUser   types.Bool    `tfsdk:"user_permissions_sf_content_user"`
+	UserPermissionsSupportUser     types.Bool    `tfsdk:"user_permissions_support_user"`
+	UserPermissionsInteractionUser types.Bool    `tfsdk:"user_permissions_interaction_user"`
+}
+
+// Custom User struct that implements force.SObject, nil fields are sent as null to clear them
 type customUser struct {
-	sobjects.User
-	UserRoleId string `json:"UserRoleId,omitempty"`
+	Alias                string  `json:"Alias"`
+	Email                string  `json:"Email"`
+	LastName             string  `json:"LastName"`
+	ProfileId            string  `json:"ProfileId"`
+	Username             string  `json:"Username"`
+	EmailEncodingKey     string  `json:"EmailEncodingKey"`
+	LanguageLocaleKey    string  `json:"LanguageLocaleKey"`
+	LocaleSidKey         string  `json:"LocaleSidKey"`
+	TimeZoneSidKey       string  `json:"TimeZoneSidKey"`
+	UserRoleId           *string `json:"UserRoleId"`
+	FirstName            *string `json:"FirstName"`
+	Title                *string `json:"Title"`
+	Department           *string `json:"Department"`
+	CompanyName          *string `json:"CompanyName"`
+	ManagerId            *string `json:"ManagerId"`
+	FederationIdentifier *string `json:"FederationIdentifier"`
+	Phone                *string `json:"Phone"`
+	MobilePhone          *string `json:"MobilePhone"`
+	EmployeeNumber       *string `json:"EmployeeNumber"`
+	Street               *string `json:"Street"`
+	City                 *string `json:"City"`
+	State                *string `json:"State"`
+	PostalCode           *string `json:"PostalCode"`
+	Country              *string `json:"Country"`
+
+	// generated by salesforce when unset, and the permission fields only exist when the feature is enabled
+	CommunityNickname              *string `json:"CommunityNickname,omitempty" force:",omitempty"`
+	UserPermissionsMarketingUser   *bool   `json:"UserPermissionsMarketingUser,omitempty" force:",omitempty"`
+	UserPermissionsKnowledgeUser   *bool   `json:"UserPermissionsKnowledgeUser,omitempty" force:",omitempty"`
+	UserPermissionsOfflineUser     *bool   `json:"UserPermissionsOfflineUser,omitempty" force:",omitempty"`
+	UserPermissionsSFContentUser   *bool   `json:"UserPermissionsSFContentUser,omitempty" force:",omitempty"`
+	UserPermissionsSupportUser     *bool   `json:"UserPermissionsSupportUser,omitempty" force:",omitempty"`
+	UserPermissionsInteractionUser *bool   `json:"UserPermissionsInteractionUser,omitempty" force:",omitempty"`
 }
 
 func (u customUser) ApiName() string {
@@ -124,6 +284,131 @@ func (u customUser) ExternalIdApiName() string {
 	return ""
 }
 
+// userLogin is the writable part of the UserLogin record salesforce keeps for every user
+type userLogin struct {
+	IsFrozen bool `json:"IsFrozen"`
+}
+
+func (u userLogin) ApiName() string {
+	return "UserLogin"
+}
+
+func (u userLogin) ExternalIdApiName() string {
+	return ""
+}
+
+type userLoginRecord struct {
+	Id string `json:"Id"`
+	userLogin
+}
+
+func (r *userResource) getUserLogin(userId string) (userLoginRecord, error) {
+	query := fmt.Sprintf("SELECT Id, IsFrozen FROM UserLogin WHERE UserId = '%s'", soqlEscape(userId))
+	records, err := queryAllRecords[userLoginRecord](r.client, query)
+	if err != nil {
+		return userLoginRecord{}, err
+	}
+	if len(records) == 0 {
+		return userLoginRecord{}, fmt.Errorf("no UserLogin where UserId = '%s'", userId)
+	}
+	return records[0], nil
+}
+
+// setFrozen freezes or unfreezes the user, skipping the update when it's already in that state
+func (r *userResource) setFrozen(userId string, frozen bool) error {
+	login, err := r.getUserLogin(userId)
+	if err != nil {
+		return err
+	}
+	if login.IsFrozen == frozen {
+		return nil
+	}
+	return r.client.UpdateSObject(login.Id, userLogin{IsFrozen: frozen})
+}
+
+// userPermissionPointer returns the planned feature license only when it differs from state, so
+// only configured ones are sent on create and changed ones on update. The fields don't exist in
+// orgs without the feature, sending unmanaged ones would fail unrelated changes
+func userPermissionPointer(plan, state types.Bool) *bool {
+	if plan.IsUnknown() || plan.Equal(state) {
+		return nil
+	}
+	return plan.ValueBoolPointer()
+}
+
+// expandUser builds the writable User fields from the plan, state is empty on create
+func expandUser(data, state userResourceModel) customUser {
+	user := customUser{
+		Alias:                          data.Alias.ValueString(),
+		Email:                          data.Email.ValueString(),
+		LastName:                       data.LastName.ValueString(),
+		ProfileId:                      data.ProfileID.ValueString(),
+		Username:                       data.Username.ValueString(),
+		EmailEncodingKey:               data.EmailEncodingKey.ValueString(),
+		LanguageLocaleKey:              data.LanguageLocaleKey.ValueString(),
+		LocaleSidKey:                   data.LocaleSidKey.ValueString(),
+		TimeZoneSidKey:                 data.TimeZoneSidKey.ValueString(),
+		UserRoleId:                     data.UserRoleId.ValueStringPointer(),
+		FirstName:                      data.FirstName.ValueStringPointer(),
+		Title:                          data.Title.ValueStringPointer(),
+		Department:                     data.Department.ValueStringPointer(),
+		CompanyName:                    data.CompanyName.ValueStringPointer(),
+		ManagerId:                      data.ManagerId.ValueStringPointer(),
+		FederationIdentifier:           data.FederationIdentifier.ValueStringPointer(),
+		Phone:                          data.Phone.ValueStringPointer(),
+		MobilePhone:                    data.MobilePhone.ValueStringPointer(),
+		EmployeeNumber:                 data.EmployeeNumber.ValueStringPointer(),
+		UserPermissionsMarketingUser:   userPermissionPointer(data.UserPermissionsMarketingUser, state.UserPermissionsMarketingUser),
+		UserPermissionsKnowledgeUser:   userPermissionPointer(data.UserPermissionsKnowledgeUser, state.UserPermissionsKnowledgeUser),
+		UserPermissionsOfflineUser:     userPermissionPointer(data.UserPermissionsOfflineUser, state.UserPermissionsOfflineUser),
+		UserPermissionsSFContentUser:   userPermissionPointer(data.UserPermissionsSFContentUser, state.UserPermissionsSFContentUser),
+		UserPermissionsSupportUser:     userPermissionPointer(data.UserPermissionsSupportUser, state.UserPermissionsSupportUser),
+		UserPermissionsInteractionUser: userPermissionPointer(data.UserPermissionsInteractionUser, state.UserPermissionsInteractionUser),
+	}
+	if !data.CommunityNickname.IsUnknown() {
+		user.CommunityNickname = data.CommunityNickname.ValueStringPointer()
+	}
+	if a := data.Address; a != nil {
+		user.Street = a.Street.ValueStringPointer()
+		user.City = a.City.ValueStringPointer()
+		user.State = a.State.ValueStringPointer()
+		user.PostalCode = a.PostalCode.ValueStringPointer()
+		user.Country = a.Country.ValueStringPointer()
+	}
+	return user
+}
+
+// flattenUser sets the model from a User read from the API, permissions missing from the org read as false
+func flattenUser(data *userResourceModel, user customUser) {
+	data.Alias = types.StringValue(user.Alias)
+	data.Email = types.StringValue(user.Email)
+	data.EmailEncodingKey = types.StringValue(user.EmailEncodingKey)
+	data.LanguageLocaleKey = types.StringValue(user.LanguageLocaleKey)
+	data.LastName = types.StringValue(user.LastName)
+	data.LocaleSidKey = types.StringValue(user.LocaleSidKey)
+	data.ProfileID = types.StringValue(user.ProfileId)
+	data.TimeZoneSidKey = types.StringValue(user.TimeZoneSidKey)
+	data.Username = types.StringValue(user.Username)
+	data.UserRoleId = sameId(data.UserRoleId, user.UserRoleId)
+	data.FirstName = types.StringPointerValue(user.FirstName)
+	data.Title = types.StringPointerValue(user.Title)
+	data.Department = types.StringPointerValue(user.Department)
+	data.CompanyName = types.StringPointerValue(user.CompanyName)
+	data.ManagerId = sameId(data.ManagerId, user.ManagerId)
+	data.FederationIdentifier = types.StringPointerValue(user.FederationIdentifier)
+	data.Phone = types.StringPointerValue(user.Phone)
+	data.MobilePhone = types.StringPointerValue(user.MobilePhone)
+	data.EmployeeNumber = types.StringPointerValue(user.EmployeeNumber)
+	data.CommunityNickname = types.StringPointerValue(user.CommunityNickname)
+	data.Address = flattenAddress(data.Address, user.Street, user.City, user.State, user.PostalCode, user.Country)
+	data.UserPermissionsMarketingUser = types.BoolValue(user.UserPermissionsMarketingUser != nil && *user.UserPermissionsMarketingUser)
+	data.UserPermissionsKnowledgeUser = types.BoolValue(user.UserPermissionsKnowledgeUser != nil && *user.UserPermissionsKnowledgeUser)
+	data.UserPermissionsOfflineUser = types.BoolValue(user.UserPermissionsOfflineUser != nil && *user.UserPermissionsOfflineUser)
+	data.UserPermissionsSFContentUser = types.BoolValue(user.UserPermissionsSFContentUser != nil && *user.UserPermissionsSFContentUser)
+	data.UserPermissionsSupportUser = types.BoolValue(user.UserPermissionsSupportUser != nil && *user.UserPermissionsSupportUser)
+	data.UserPermissionsInteractionUser = types.BoolValue(user.UserPermissionsInteractionUser != nil && *user.UserPermissionsInteractionUser)
+}
+
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data userResourceModel
 	diags := req.Plan.Get(ctx, &data)
@@ -132,39 +417,59 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	user := &sobjects.User{
-		Alias:             data.Alias.ValueString(),
-		Email:             data.Email.ValueString(),
-		EmailEncodingKey:  data.EmailEncodingKey.ValueString(),
-		LanguageLocaleKey: data.LanguageLocaleKey.ValueString(),
-		LastName:          data.LastName.ValueString(),
-		LocaleSidKey:      data.LocaleSidKey.ValueString(),
-		ProfileId:         data.ProfileID.ValueString(),
-		TimeZoneSidKey:    data.TimeZoneSidKey.ValueString(),
-		Username:          data.Username.ValueString(),
-	}
-	
-	// UserRoleId is not in sobjects.User, so we need to use a custom struct
-	if !data.UserRoleId.IsNull() {
-		customUser := customUser{
-			User:       *user,
-			UserRoleId: data.UserRoleId.ValueString(),
-		}
-		sfResp, err := r.client.InsertSObject(customUser)
-		if err != nil {
-			resp.Diagnostics.AddError("Error Inserting User", err.Error())
-			return
-		}
-		data.Id = types.StringValue(sfResp.Id)
+	sfResp, err := r.client.InsertSObject(expandUser(data, userResourceModel{}))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Inserting User", err.Error())
+		return
+	}
+	data.Id = types.StringValue(sfResp.Id)
+
+	// fill in what salesforce generated or defaulted, leaving configured values as planned. Users can't
+	// be deleted, so a failed read only warns and leaves them null, Read fills them in on the next refresh
+	var read userResourceModel
+	var created customUser
+	if err := r.client.GetSObject(sfResp.Id, nil, &created); err != nil {
+		resp.Diagnostics.AddWarning("Error Getting User", fmt.Sprintf("The user was created but the values generated by Salesforce couldn't be read: %s", err))
 	} else {
-		sfResp, err := r.client.InsertSObject(user)
-		if err != nil {
-			resp.Diagnostics.AddError("Error Inserting User", err.Error())
-			return
+		flattenUser(&read, created)
+	}
+	if data.CommunityNickname.IsUnknown() {
+		data.CommunityNickname = read.CommunityNickname
+	}
+	for _, v := range []struct{ planned, read *types.Bool }{
+		{&data.UserPermissionsMarketingUser, &read.UserPermissionsMarketingUser},
+		{&data.UserPermissionsKnowledgeUser, &read.UserPermissionsKnowledgeUser},
+		{&data.UserPermissionsOfflineUser, &read.UserPermissionsOfflineUser},
+		{&data.UserPermissionsSFContentUser, &read.UserPermissionsSFContentUser},
+		{&data.UserPermissionsSupportUser, &read.UserPermissionsSupportUser},
+		{&data.UserPermissionsInteractionUser, &read.UserPermissionsInteractionUser},
+	} {
+		if v.planned.IsUnknown() {
+			*v.planned = *v.read
 		}
-		data.Id = types.StringValue(sfResp.Id)
 	}
 
+	// users can't be deleted, save the new user before freezing it so a failure doesn't orphan it,
+	// is_frozen stays false in state and freezing is retried on the next apply. It stays null when
+	// not configured so Read doesn't need access to UserLogin
+	freeze := data.IsFrozen.ValueBool()
+	if data.IsFrozen.IsUnknown() {
+		data.IsFrozen = types.BoolNull()
+	} else {
+		data.IsFrozen = types.BoolValue(false)
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !freeze {
+		return
+	}
+
+	if err := r.setFrozen(sfResp.Id, true); err != nil {
+		resp.Diagnostics.AddError("Error Freezing User", err.Error())
+		return
+	}
+	data.IsFrozen = types.BoolValue(true)
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -177,60 +482,59 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Use custom user to get UserRoleId which is not in sobjects.User
-	var customUser customUser
-	if err := r.client.GetSObject(data.Id.ValueString(), nil, &customUser); err != nil {
+	var user customUser
+	if err := r.client.GetSObject(data.Id.ValueString(), nil, &user); err != nil {
 		resp.Diagnostics.AddError("Error Getting User", err.Error())
 		return
 	}
 
-	data.Alias = types.StringValue(customUser.Alias)
-	data.Email = types.StringValue(customUser.Email)
-	data.EmailEncodingKey = types.StringValue(customUser.EmailEncodingKey)
-	data.LanguageLocaleKey = types.StringValue(customUser.LanguageLocaleKey)
-	data.LastName = types.StringValue(customUser.LastName)
-	data.LocaleSidKey = types.StringValue(customUser.LocaleSidKey)
-	data.ProfileID = types.StringValue(customUser.ProfileId)
-	data.TimeZoneSidKey = types.StringValue(customUser.TimeZoneSidKey)
-	data.Username = types.StringValue(customUser.Username)
-	data.UserRoleId = types.StringValue(customUser.UserRoleId)
+	flattenUser(&data, user)
+	// only read back when managed, the integration user may not have access to UserLogin
+	if !data.IsFrozen.IsNull() {
+		login, err := r.getUserLogin(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Getting User Login", err.Error())
+			return
+		}
+		data.IsFrozen = types.BoolValue(login.IsFrozen)
+	}
+	// nothing to read back for reset_password, it's null after import
+	if data.ResetPassword.IsNull() {
+		data.ResetPassword = types.BoolValue(false)
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data userResourceModel
-	diags := req.Plan.Get(ctx, &data)
-	resp.Diagnostics.Append(diags...)
+	var data, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	user := &sobjects.User{
-		Alias:             data.Alias.ValueString(),
-		Email:             data.Email.ValueString(),
-		EmailEncodingKey:  data.EmailEncodingKey.ValueString(),
-		LanguageLocaleKey: data.LanguageLocaleKey.ValueString(),
-		LastName:          data.LastName.ValueString(),
-		LocaleSidKey:      data.LocaleSidKey.ValueString(),
-		ProfileId:         data.ProfileID.ValueString(),
-		TimeZoneSidKey:    data.TimeZoneSidKey.ValueString(),
-		Username:          data.Username.ValueString(),
+	if err := r.client.UpdateSObject(data.Id.ValueString(), expandUser(data, state)); err != nil {
+		resp.Diagnostics.AddError("Error Updating User", err.Error())
+		return
 	}
 
-	// Always use custom user since UserRoleId is not in sobjects.User
-	customUser := customUser{
-		User: *user,
-	}
-	if !data.UserRoleId.IsNull() {
-		customUser.UserRoleId = data.UserRoleId.ValueString()
+	// the user is updated, save it before freezing so a failure leaves is_frozen as it was and
+	// freezing is retried on the next apply
+	frozen := data.IsFrozen
+	data.IsFrozen = state.IsFrozen
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || frozen.IsUnknown() || frozen.Equal(state.IsFrozen) {
+		return
 	}
 
-	if err := r.client.UpdateSObject(data.Id.ValueString(), customUser); err != nil {
-		resp.Diagnostics.AddError("Error Updating User", err.Error())
+	if err := r.setFrozen(data.Id.ValueString(), frozen.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Error Freezing User", err.Error())
 		return
 	}
+	data.IsFrozen = frozen
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -244,8 +548,12 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	if err := r.client.DeleteSObject(data.Id.ValueString(), nil); err != nil {
+	if err := r.client.DeleteSObject(data.Id.ValueString(), customUser{}); err != nil {
 		resp.Diagnostics.AddError("Error Deleting User", err.Error())
 		return
 	}
 }
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
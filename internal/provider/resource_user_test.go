@@ -9,9 +9,35 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestUserPermissionPointer(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		plan, state types.Bool
+		want        *bool
+	}{
+		// unset on create
+		{types.BoolUnknown(), types.BoolNull(), nil},
+		// configured on create
+		{types.BoolValue(false), types.BoolNull(), boolPointer(false)},
+		// unset or unchanged on update, the plan holds the state value
+		{types.BoolValue(false), types.BoolValue(false), nil},
+		{types.BoolValue(true), types.BoolValue(true), nil},
+		// changed on update
+		{types.BoolValue(false), types.BoolValue(true), boolPointer(false)},
+	}
+	for _, c := range cases {
+		got := userPermissionPointer(c.plan, c.state)
+		if (got == nil) != (c.want == nil) || (got != nil && *got != *c.want) {
+			t.Errorf("userPermissionPointer(%s, %s) = %v, want %v", c.plan, c.state, got, c.want)
+		}
+	}
+}
+
 func TestAccResourceUser_basic(t *testing.T) {
 	t.Parallel()
 	t.Skip("Users cannot be deleted and there are limited licenses, skipping, comment out this line to run locally")
@@ -85,6 +111,118 @@ func TestAccResourceUser_update(t *testing.T) {
 	})
 }
 
+func TestAccResourceUser_profileFields(t *testing.T) {
+	t.Parallel()
+	t.Skip("Users cannot be deleted and there are limited licenses, skipping, comment out this line to run locally")
+
+	email := os.Getenv("SALESFORCE_USERNAME")
+	username := testAccUserUsername(email)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_profileFields(email, username, "Engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "federation_identifier", username),
+					resource.TestCheckResourceAttr("salesforce_user.test", "address.city", "San Francisco"),
+					resource.TestCheckResourceAttr("salesforce_user.test", "user_permissions_marketing_user", "false"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceUser_profileFields(email, username, "Support"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "department", "Support"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_featureLicenses(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("SALESFORCE_MARKETING_USER_LICENSE") == "" {
+		t.Skip("SALESFORCE_MARKETING_USER_LICENSE must be set in an org with a free Marketing User feature license to run this test, users cannot be deleted")
+	}
+	email := os.Getenv("SALESFORCE_USERNAME")
+	username := testAccUserUsername(email)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_featureLicenses(email, username, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "user_permissions_marketing_user", "true"),
+				),
+			},
+			{
+				Config: testAccResourceUser_featureLicenses(email, username, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "user_permissions_marketing_user", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_frozen(t *testing.T) {
+	t.Parallel()
+	t.Skip("Users cannot be deleted and there are limited licenses, skipping, comment out this line to run locally")
+
+	email := os.Getenv("SALESFORCE_USERNAME")
+	username := testAccUserUsername(email)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUser_frozen(email, username, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "is_frozen", "false"),
+				),
+			},
+			{
+				Config: testAccResourceUser_frozen(email, username, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "is_frozen", "true"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// is_frozen is only read back once managed
+				ImportStateVerifyIgnore: []string{"is_frozen"},
+			},
+			{
+				Config: testAccResourceUser_frozen(email, username, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_user.test", "is_frozen", "false"),
+				),
+			},
+		},
+	})
+}
+
+// testAccUserUsername returns a unique username in the same mailbox as email
+func testAccUserUsername(email string) string {
+	parts := strings.Split(email, "@")
+	if !strings.Contains(parts[0], "+") {
+		return fmt.Sprintf("%s+%s@%s", parts[0], RandString(10), parts[1])
+	}
+	return fmt.Sprintf("%s-%s@%s", parts[0], RandString(10), parts[1])
+}
+
 func testAccResourceUser_basic(email, username string) string {
 	return fmt.Sprintf(`
 data "salesforce_profile" "standard" {
@@ -151,3 +289,66 @@ resource "salesforce_user" "test" {
 }
 `, email, username)
 }
+
+func testAccResourceUser_profileFields(email, username, department string) string {
+	return fmt.Sprintf(`
+data "salesforce_profile" "standard" {
+  name = "Standard User"
+}
+
+resource "salesforce_user" "test" {
+  alias                 = "test"
+  email                 = "%[1]s"
+  last_name             = "test"
+  username              = "%[2]s"
+  profile_id            = data.salesforce_profile.standard.id
+  first_name            = "test"
+  title                 = "Tester"
+  department            = "%[3]s"
+  company_name          = "Example Inc."
+  federation_identifier = "%[2]s"
+  phone                 = "555-1234"
+  mobile_phone          = "555-5678"
+  employee_number       = "E-0001"
+  address = {
+    street  = "415 Mission Street"
+    city    = "San Francisco"
+    country = "USA"
+  }
+}
+`, email, username, department)
+}
+
+func testAccResourceUser_featureLicenses(email, username string, marketing bool) string {
+	return fmt.Sprintf(`
+data "salesforce_profile" "standard" {
+  name = "Standard User"
+}
+
+resource "salesforce_user" "test" {
+  alias                           = "test"
+  email                           = "%s"
+  last_name                       = "test"
+  username                        = "%s"
+  profile_id                      = data.salesforce_profile.standard.id
+  user_permissions_marketing_user = %t
+}
+`, email, username, marketing)
+}
+
+func testAccResourceUser_frozen(email, username string, frozen bool) string {
+	return fmt.Sprintf(`
+data "salesforce_profile" "standard" {
+  name = "Standard User"
+}
+
+resource "salesforce_user" "test" {
+  alias      = "test"
+  email      = "%s"
+  last_name  = "test"
+  username   = "%s"
+  profile_id = data.salesforce_profile.standard.id
+  is_frozen  = %t
+}
+`, email, username, frozen)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+// validationRuleAttributes maps the fields named by Metadata API errors to attributes
+var validationRuleAttributes = map[string]path.Path{
+	"errorConditionFormula": path.Root("formula"),
+	"errorDisplayField":     path.Root("error_display_field"),
+	"errorMessage":          path.Root("error_message"),
+	"fullName":              path.Root("name"),
+}
+
+type validationRuleResource struct {
+	metadata *metadata.Client
+}
+
+var (
+	_ resource.Resource                = &validationRuleResource{}
+	_ resource.ResourceWithImportState = &validationRuleResource{}
+)
+
+func (r *validationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "salesforce_validation_rule"
+}
+
+func (r *validationRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Validation Rule Resource for the Salesforce Provider, managed through the Metadata API. Changes made to the formula in Setup show as drift, formula errors are reported on the formula attribute.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the resource, the same as full_name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object": schema.StringAttribute{
+				Description: "API name of the object the rule validates, such as Account or Widget__c. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name of the rule on the object. It must begin with a letter and contain only letters, digits and single underscores. Forces replacement if updated.",
+				Required:    true,
+				Validators: []validator.String{
+					customName{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Description: "Full name of the rule in the format Object.Name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the rule is enforced. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					booleanNilIsTrue{},
+				},
+			},
+			"formula": schema.StringAttribute{
+				Description: "Formula that blocks saving a record when it's true. Differences in line endings and trailing whitespace aren't reported as drift.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"error_message": schema.StringAttribute{
+				Description: "Message shown to the user when the rule blocks a save.",
+				Required:    true,
+				Validators: []validator.String{
+					notEmptyString{},
+				},
+			},
+			"error_display_field": schema.StringAttribute{
+				Description: "API name of the field the error message is shown next to, at the top of the page if unset.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rule.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+type validationRuleResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Object            types.String `tfsdk:"object"`
+	Name              types.String `tfsdk:"name"`
+	FullName          types.String `tfsdk:"full_name"`
+	Active            types.Bool   `tfsdk:"active"`
+	Formula           types.String `tfsdk:"formula"`
+	ErrorMessage      types.String `tfsdk:"error_message"`
+	ErrorDisplayField types.String `tfsdk:"error_display_field"`
+	Description       types.String `tfsdk:"description"`
+}
+
+// normalizeFormula removes the differences Salesforce may introduce when storing a formula, such as CRLF line endings
+func normalizeFormula(formula string) string {
+	lines := strings.Split(strings.ReplaceAll(formula, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func expandValidationRule(data validationRuleResourceModel) metadata.ValidationRule {
+	return metadata.ValidationRule{
+		FullName:              data.Object.ValueString() + "." + data.Name.ValueString(),
+		Active:                data.Active.ValueBool(),
+		Description:           data.Description.ValueString(),
+		ErrorConditionFormula: data.Formula.ValueString(),
+		ErrorDisplayField:     data.ErrorDisplayField.ValueString(),
+		ErrorMessage:          data.ErrorMessage.ValueString(),
+	}
+}
+
+func flattenValidationRule(data *validationRuleResourceModel, rule metadata.ValidationRule) {
+	object, name, _ := strings.Cut(rule.FullName, ".")
+	data.Id = types.StringValue(rule.FullName)
+	data.FullName = types.StringValue(rule.FullName)
+	data.Object = types.StringValue(object)
+	data.Name = types.StringValue(name)
+	data.Active = types.BoolValue(rule.Active)
+	data.ErrorMessage = types.StringValue(rule.ErrorMessage)
+	data.ErrorDisplayField = metadataString(rule.ErrorDisplayField)
+	data.Description = metadataString(rule.Description)
+	// keep the formula as configured unless it was changed in Setup
+	if data.Formula.IsNull() || normalizeFormula(data.Formula.ValueString()) != normalizeFormula(rule.ErrorConditionFormula) {
+		data.Formula = types.StringValue(rule.ErrorConditionFormula)
+	}
+}
+
+func (r *validationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data validationRuleResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule := expandValidationRule(data)
+	if err := r.metadata.Create(ctx, rule); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Creating Validation Rule", err, validationRuleAttributes)...)
+		return
+	}
+	data.Id = types.StringValue(rule.FullName)
+	data.FullName = types.StringValue(rule.FullName)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *validationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data validationRuleResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, found, err := metadata.Read[metadata.ValidationRule](ctx, r.metadata, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Validation Rule", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	flattenValidationRule(&data, rule)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *validationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data validationRuleResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Update(ctx, expandValidationRule(data)); err != nil {
+		resp.Diagnostics.Append(metadataDiagnostics("Error Updating Validation Rule", err, validationRuleAttributes)...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *validationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data validationRuleResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.metadata.Delete(ctx, "ValidationRule", data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Validation Rule", err.Error())
+		return
+	}
+}
+
+func (r *validationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	object, name, ok := strings.Cut(req.ID, ".")
+	if !ok || object == "" || checkCustomName(name, "") != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: Object.Rule_Name. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+)
+
+func TestFlattenValidationRuleFormula(t *testing.T) {
+	t.Parallel()
+
+	data := validationRuleResourceModel{Formula: types.StringValue("AND(\n  Amount__c < 0,\n  ISPICKVAL(Status__c, 'Paid')\n)\n")}
+	rule := metadata.ValidationRule{
+		FullName:              "Invoice__c.Amount_Positive",
+		ErrorConditionFormula: "AND(\r\n  Amount__c < 0,  \r\n  ISPICKVAL(Status__c, 'Paid')\r\n)",
+	}
+	flattenValidationRule(&data, rule)
+	if data.Formula.ValueString() != "AND(\n  Amount__c < 0,\n  ISPICKVAL(Status__c, 'Paid')\n)\n" {
+		t.Errorf("expected the configured formula to be kept, got %q", data.Formula.ValueString())
+	}
+	if data.Object.ValueString() != "Invoice__c" || data.Name.ValueString() != "Amount_Positive" {
+		t.Errorf("unexpected object and name %s, %s", data.Object, data.Name)
+	}
+
+	// an edit in Setup is drift
+	rule.ErrorConditionFormula = "Amount__c <= 0"
+	flattenValidationRule(&data, rule)
+	if data.Formula.ValueString() != "Amount__c <= 0" {
+		t.Errorf("expected the changed formula, got %q", data.Formula.ValueString())
+	}
+}
+
+func TestMetadataDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	err := &metadata.ResultError{
+		FullName: "Invoice__c.Amount_Positive",
+		Errors: []metadata.Error{
+			{StatusCode: "FIELD_INTEGRITY_EXCEPTION", Message: "Field Amount does not exist. Check spelling.", Fields: []string{"errorConditionFormula"}},
+			{StatusCode: "DUPLICATE_DEVELOPER_NAME", Message: "The name is already used."},
+		},
+	}
+	diags := metadataDiagnostics("Error Creating Validation Rule", err, validationRuleAttributes)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+	formulaDiag, ok := diags[0].(interface{ Path() path.Path })
+	if !ok || !formulaDiag.Path().Equal(path.Root("formula")) {
+		t.Errorf("expected the formula error on the formula attribute, got %#v", diags[0])
+	}
+	if _, ok := diags[1].(interface{ Path() path.Path }); ok {
+		t.Errorf("expected the name error without an attribute, got %#v", diags[1])
+	}
+	if diags[1].Detail() != "Invoice__c.Amount_Positive: DUPLICATE_DEVELOPER_NAME: The name is already used." {
+		t.Errorf("unexpected detail %q", diags[1].Detail())
+	}
+}
+
+func TestAccResourceValidationRule_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf_test_%s", RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceValidationRule_basic(name, "NumberOfEmployees < 0", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_validation_rule.test", "id", "Account."+name),
+					resource.TestCheckResourceAttr("salesforce_validation_rule.test", "full_name", "Account."+name),
+					resource.TestCheckResourceAttr("salesforce_validation_rule.test", "active", "true"),
+				),
+			},
+			{
+				ResourceName:      "salesforce_validation_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceValidationRule_basic(name, "NumberOfEmployees < 1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_validation_rule.test", "formula", "NumberOfEmployees < 1"),
+					resource.TestCheckResourceAttr("salesforce_validation_rule.test", "active", "false"),
+				),
+			},
+			{
+				Config:      testAccResourceValidationRule_basic(name, "NotAField__c < 1", false),
+				ExpectError: regexp.MustCompile(`(?s)formula.*NotAField__c`),
+			},
+		},
+	})
+}
+
+func testAccResourceValidationRule_basic(name, formula string, active bool) string {
+	return fmt.Sprintf(`
+resource "salesforce_validation_rule" "test" {
+  object              = "Account"
+  name                = "%s"
+  active              = %t
+  formula             = "%s"
+  error_message       = "Employees can't be negative"
+  error_display_field = "NumberOfEmployees"
+}
+`, name, active, formula)
+}
@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nimajalali/go-force/force"
+)
+
+// dynamicSObject implements force.SObject for objects whose type and fields are only known at runtime
+type dynamicSObject struct {
+	apiName           string
+	externalIdApiName string
+	fields            map[string]interface{}
+}
+
+func (o dynamicSObject) ApiName() string {
+	return o.apiName
+}
+
+func (o dynamicSObject) ExternalIdApiName() string {
+	return o.externalIdApiName
+}
+
+func (o dynamicSObject) MarshalJSON() ([]byte, error) {
+	if o.fields == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(o.fields)
+}
+
+func (o *dynamicSObject) UnmarshalJSON(data []byte) error {
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	delete(fields, "attributes")
+	o.fields = fields
+	return nil
+}
+
+// upsertSObject upserts a record by the external ID field named by in.ExternalIdApiName() and returns its
+// ID. Salesforce only returns the ID in the body when it creates the record, so it is fetched when updating.
+func upsertSObject(client *force.ForceApi, in force.SObject, externalId string) (string, error) {
+	escaped := url.PathEscape(externalId)
+	resp, err := client.UpsertSObjectByExternalId(escaped, in)
+	if err != nil {
+		return "", err
+	}
+	if resp != nil && resp.Id != "" {
+		return resp.Id, nil
+	}
+
+	existing := dynamicSObject{apiName: in.ApiName(), externalIdApiName: in.ExternalIdApiName()}
+	if err := client.GetSObjectByExternalId(escaped, []string{"Id"}, &existing); err != nil {
+		return "", err
+	}
+	id, _ := lookupSObjectField(existing.fields, "Id")
+	if s, ok := id.(string); ok && s != "" {
+		return s, nil
+	}
+	return "", fmt.Errorf("no Id returned for %s with %s %s", in.ApiName(), in.ExternalIdApiName(), externalId)
+}
+
+// go-force caches describe results in an unguarded map, serialize access to it
+var describeMutex sync.Mutex
+
+func describeSObject(client *force.ForceApi, objectType string) (*force.SObjectDescription, error) {
+	describeMutex.Lock()
+	defer describeMutex.Unlock()
+	return client.DescribeSObject(dynamicSObject{apiName: objectType})
+}
+
+// field names are case insensitive in the API, key the describe fields by their lower case name
+func sobjectFieldsByName(desc *force.SObjectDescription) map[string]*force.SObjectField {
+	fields := make(map[string]*force.SObjectField, len(desc.Fields))
+	for _, field := range desc.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+	return fields
+}
+
+// lookupSObjectField finds the value of a field in an API response without regard to case
+func lookupSObjectField(fields map[string]interface{}, name string) (interface{}, bool) {
+	if v, ok := fields[name]; ok {
+		return v, true
+	}
+	for k, v := range fields {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// requiredSObjectFields returns the fields Salesforce requires a value for on create
+func requiredSObjectFields(desc *force.SObjectDescription) []*force.SObjectField {
+	var required []*force.SObjectField
+	for _, field := range desc.Fields {
+		if field.Createable && !field.Nillable && !field.DefaultedOnCreate && field.Type != "boolean" {
+			required = append(required, field)
+		}
+	}
+	return required
+}
+
+// dynamicElements returns the elements of the object or map held by a dynamic value
+func dynamicElements(ctx context.Context, v types.Dynamic) (map[string]attr.Value, error) {
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	switch u := v.UnderlyingValue().(type) {
+	case types.Object:
+		return u.Attributes(), nil
+	case types.Map:
+		return u.Elements(), nil
+	}
+	return nil, fmt.Errorf("expected an object or map, got %s", v.UnderlyingValue().Type(ctx))
+}
+
+// dynamicWithElements rebuilds the object or map held by a dynamic value with new elements of the same types
+func dynamicWithElements(ctx context.Context, like types.Dynamic, elements map[string]attr.Value) (types.Dynamic, error) {
+	switch u := like.UnderlyingValue().(type) {
+	case types.Object:
+		obj, diags := types.ObjectValue(u.AttributeTypes(ctx), elements)
+		if diags.HasError() {
+			return like, fmt.Errorf("unable to build object: %v", diags)
+		}
+		return types.DynamicValue(obj), nil
+	case types.Map:
+		m, diags := types.MapValue(u.ElementType(ctx), elements)
+		if diags.HasError() {
+			return like, fmt.Errorf("unable to build map: %v", diags)
+		}
+		return types.DynamicValue(m), nil
+	}
+	return like, fmt.Errorf("expected an object or map, got %s", like.UnderlyingValue().Type(ctx))
+}
+
+// sobjectValueToInterface converts a terraform value into its JSON representation for the API
+func sobjectValueToInterface(v attr.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+	switch v := v.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Number:
+		n := v.ValueBigFloat()
+		if n.IsInt() {
+			if i, acc := n.Int64(); acc == big.Exact {
+				return i, nil
+			}
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case types.Dynamic:
+		return sobjectValueToInterface(v.UnderlyingValue())
+	}
+	return nil, fmt.Errorf("unsupported value %T", v)
+}
+
+var sobjectDateTimeLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05.000Z0700",
+	time.RFC3339,
+	time.RFC3339Nano,
+}
+
+func parseSObjectDateTime(s string) (time.Time, bool) {
+	for _, layout := range sobjectDateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// sobjectValueFromInterface converts a value returned by the API into a terraform value of the same
+// type as like, preferring like when the two only differ in representation to avoid spurious diffs
+func sobjectValueFromInterface(raw interface{}, like attr.Value, field *force.SObjectField) (attr.Value, error) {
+	if d, ok := like.(types.Dynamic); ok {
+		v, err := sobjectValueFromInterface(raw, d.UnderlyingValue(), field)
+		if err != nil {
+			return nil, err
+		}
+		return types.DynamicValue(v), nil
+	}
+
+	switch like.(type) {
+	case types.String:
+		if raw == nil {
+			return types.StringNull(), nil
+		}
+		var s string
+		switch r := raw.(type) {
+		case string:
+			s = r
+		case float64:
+			s = strconv.FormatFloat(r, 'f', -1, 64)
+		case bool:
+			s = strconv.FormatBool(r)
+		default:
+			return nil, fmt.Errorf("cannot convert %T to string", raw)
+		}
+		if prior, ok := like.(types.String); ok && !prior.IsNull() && !prior.IsUnknown() {
+			p := prior.ValueString()
+			if field != nil && (field.Type == "id" || field.Type == "reference") && normalizeId(p) == normalizeId(s) {
+				return prior, nil
+			}
+			switch r := raw.(type) {
+			case float64:
+				if pf, err := strconv.ParseFloat(p, 64); err == nil && pf == r {
+					return prior, nil
+				}
+			case bool:
+				if pb, err := strconv.ParseBool(p); err == nil && pb == r {
+					return prior, nil
+				}
+			}
+			if field != nil && field.Type == "datetime" {
+				pt, pok := parseSObjectDateTime(p)
+				st, sok := parseSObjectDateTime(s)
+				if pok && sok && pt.Equal(st) {
+					return prior, nil
+				}
+			}
+		}
+		return types.StringValue(s), nil
+	case types.Bool:
+		if raw == nil {
+			return types.BoolNull(), nil
+		}
+		switch r := raw.(type) {
+		case bool:
+			return types.BoolValue(r), nil
+		case string:
+			b, err := strconv.ParseBool(r)
+			if err != nil {
+				return nil, err
+			}
+			return types.BoolValue(b), nil
+		}
+		return nil, fmt.Errorf("cannot convert %T to bool", raw)
+	case types.Number, types.Int64, types.Float64:
+		var f float64
+		switch r := raw.(type) {
+		case nil:
+			switch like.(type) {
+			case types.Int64:
+				return types.Int64Null(), nil
+			case types.Float64:
+				return types.Float64Null(), nil
+			}
+			return types.NumberNull(), nil
+		case float64:
+			f = r
+		case string:
+			parsed, err := strconv.ParseFloat(r, 64)
+			if err != nil {
+				return nil, err
+			}
+			f = parsed
+		default:
+			return nil, fmt.Errorf("cannot convert %T to number", raw)
+		}
+		switch prior := like.(type) {
+		case types.Int64:
+			return types.Int64Value(int64(f)), nil
+		case types.Float64:
+			return types.Float64Value(f), nil
+		case types.Number:
+			// configuration numbers carry more precision than the float64 the API returns
+			if !prior.IsNull() && !prior.IsUnknown() {
+				if pf, _ := prior.ValueBigFloat().Float64(); pf == f {
+					return prior, nil
+				}
+			}
+		}
+		return types.NumberValue(big.NewFloat(f)), nil
+	}
+	return nil, fmt.Errorf("unsupported value %T", like)
+}
+
+// checkSObjectFieldValue verifies a configured value is compatible with the describe type of a field
+func checkSObjectFieldValue(field *force.SObjectField, v attr.Value) error {
+	if d, ok := v.(types.Dynamic); ok {
+		v = d.UnderlyingValue()
+	}
+	if v == nil || v.IsUnknown() {
+		return nil
+	}
+	if v.IsNull() {
+		if !field.Nillable && field.Type != "boolean" {
+			return fmt.Errorf("field %s cannot be null", field.Name)
+		}
+		return nil
+	}
+
+	switch field.Type {
+	case "boolean":
+		if _, ok := v.(types.Bool); !ok {
+			return fmt.Errorf("field %s is a checkbox and requires a bool value", field.Name)
+		}
+	case "int", "double", "currency", "percent":
+		switch v.(type) {
+		case types.Number, types.Int64, types.Float64:
+		default:
+			return fmt.Errorf("field %s is of type %s and requires a number value", field.Name, field.Type)
+		}
+	case "date":
+		s, ok := v.(types.String)
+		if !ok {
+			return fmt.Errorf("field %s is a date and requires a string value", field.Name)
+		}
+		if _, err := time.Parse("2006-01-02", s.ValueString()); err != nil {
+			return fmt.Errorf("field %s is a date and must be in the format YYYY-MM-DD", field.Name)
+		}
+	case "datetime":
+		s, ok := v.(types.String)
+		if !ok {
+			return fmt.Errorf("field %s is a datetime and requires a string value", field.Name)
+		}
+		if _, ok := parseSObjectDateTime(s.ValueString()); !ok {
+			return fmt.Errorf("field %s is a datetime and must be in RFC 3339 format", field.Name)
+		}
+	default:
+		s, ok := v.(types.String)
+		if !ok {
+			return fmt.Errorf("field %s is of type %s and requires a string value", field.Name, field.Type)
+		}
+		return checkPicklistValue(field, s.ValueString())
+	}
+	return nil
+}
+
+// checkPicklistValue verifies a value is active for a restricted picklist, unrestricted picklists accept anything
+func checkPicklistValue(field *force.SObjectField, s string) error {
+	if field.Type != "picklist" || !field.RestrictedPicklist {
+		return nil
+	}
+	var values []string
+	for _, pv := range field.PicklistValues {
+		if pv.Active {
+			values = append(values, pv.Value)
+		}
+	}
+	for _, pv := range values {
+		if pv == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("field %s must be one of: [%s]", field.Name, strings.Join(values, ", "))
+}
+
+// coerceSObjectFieldString converts a string from config into the JSON value the API expects for the field
+func coerceSObjectFieldString(field *force.SObjectField, s string) (interface{}, error) {
+	switch field.Type {
+	case "boolean":
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("field %s is a checkbox and requires true or false", field.Name)
+		}
+		return b, nil
+	case "int":
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s requires an integer", field.Name)
+		}
+		return i, nil
+	case "double", "currency", "percent":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s is of type %s and requires a number", field.Name, field.Type)
+		}
+		return f, nil
+	case "date":
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			return nil, fmt.Errorf("field %s is a date and must be in the format YYYY-MM-DD", field.Name)
+		}
+	case "datetime":
+		if _, ok := parseSObjectDateTime(s); !ok {
+			return nil, fmt.Errorf("field %s is a datetime and must be in RFC 3339 format", field.Name)
+		}
+	case "picklist":
+		if err := checkPicklistValue(field, s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// sobjectValueFromRaw converts a value returned by the API into a terraform value when there is no prior
+// value to type it by. Nested relationships become objects and subquery results become tuples of objects.
+func sobjectValueFromRaw(ctx context.Context, raw interface{}) (attr.Value, error) {
+	switch r := raw.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(r), nil
+	case bool:
+		return types.BoolValue(r), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(r)), nil
+	case []interface{}:
+		elementTypes := make([]attr.Type, len(r))
+		elements := make([]attr.Value, len(r))
+		for i, item := range r {
+			v, err := sobjectValueFromRaw(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes[i] = v.Type(ctx)
+			elements[i] = v
+		}
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to build tuple: %v", diags)
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		if records, ok := r["records"]; ok {
+			if _, ok := r["totalSize"]; ok {
+				return sobjectValueFromRaw(ctx, records)
+			}
+		}
+		attrTypes := make(map[string]attr.Type, len(r))
+		attrs := make(map[string]attr.Value, len(r))
+		for k, item := range r {
+			if k == "attributes" {
+				continue
+			}
+			v, err := sobjectValueFromRaw(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = v.Type(ctx)
+			attrs[k] = v
+		}
+		obj, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to build object: %v", diags)
+		}
+		return obj, nil
+	}
+	return nil, fmt.Errorf("unsupported value %T", raw)
+}
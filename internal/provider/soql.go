@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nimajalali/go-force/force"
+	"github.com/nimajalali/go-force/sobjects"
+)
+
+var soqlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"\b", `\b`,
+	"\f", `\f`,
+)
+
+// soqlEscape escapes a string for use inside a single quoted SOQL literal
+func soqlEscape(s string) string {
+	return soqlEscaper.Replace(s)
+}
+
+var soqlLikeEscaper = strings.NewReplacer(`%`, `\%`, `_`, `\_`)
+
+// soqlLikeEscape escapes a string for use inside a LIKE pattern, wildcards in it match literally
+func soqlLikeEscape(s string) string {
+	return soqlLikeEscaper.Replace(soqlEscape(s))
+}
+
+// soqlLiteral renders a value as a SOQL literal, lists are rendered for use with IN
+func soqlLiteral(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return "'" + soqlEscape(v) + "'", nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case *big.Float:
+		return v.Text('f', -1), nil
+	case []string:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return soqlLiteral(items)
+	case []interface{}:
+		if len(v) == 0 {
+			return "", fmt.Errorf("lists must not be empty")
+		}
+		literals := make([]string, len(v))
+		for i, item := range v {
+			if _, ok := item.([]interface{}); ok {
+				return "", fmt.Errorf("lists must not be nested")
+			}
+			literal, err := soqlLiteral(item)
+			if err != nil {
+				return "", err
+			}
+			literals[i] = literal
+		}
+		return "(" + strings.Join(literals, ", ") + ")", nil
+	}
+	return "", fmt.Errorf("unsupported parameter type %T", v)
+}
+
+var soqlParameterRegexp = regexp.MustCompile(`^:[A-Za-z_][A-Za-z0-9_]*`)
+
+// bindSOQLParameters replaces :name placeholders outside of string literals with the escaped
+// literal of the matching parameter, every placeholder must have a parameter and vice versa
+func bindSOQLParameters(query string, params map[string]interface{}) (string, error) {
+	var b strings.Builder
+	used := make(map[string]bool, len(params))
+	inString := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		if inString {
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(query) {
+				i++
+				b.WriteByte(query[i])
+			} else if c == '\'' {
+				inString = false
+			}
+			continue
+		}
+		if c == '\'' {
+			inString = true
+			b.WriteByte(c)
+			continue
+		}
+		if c == ':' {
+			if match := soqlParameterRegexp.FindString(query[i:]); match != "" {
+				name := match[1:]
+				value, ok := params[name]
+				if !ok {
+					return "", fmt.Errorf("no value for parameter :%s", name)
+				}
+				literal, err := soqlLiteral(value)
+				if err != nil {
+					return "", fmt.Errorf("parameter :%s: %w", name, err)
+				}
+				used[name] = true
+				b.WriteString(literal)
+				i += len(match) - 1
+				continue
+			}
+		}
+		b.WriteByte(c)
+	}
+	if inString {
+		return "", fmt.Errorf("unterminated string literal")
+	}
+	for name := range params {
+		if !used[name] {
+			return "", fmt.Errorf("parameter %s is not referenced in the query", name)
+		}
+	}
+	return b.String(), nil
+}
+
+// isReadOnlySOQL reports whether the statement is a plain SELECT, SOQL has no DML but this keeps
+// SOSL and anything else unexpected out
+func isReadOnlySOQL(query string) bool {
+	fields := strings.Fields(query)
+	return len(fields) > 0 && strings.EqualFold(fields[0], "SELECT")
+}
+
+// queryAllRecords runs a query following nextRecordsUrl until every page of records has been read
+func queryAllRecords[T any](client *force.ForceApi, query string) ([]T, error) {
+	type page struct {
+		sobjects.BaseQuery
+		Records []T
+	}
+
+	var resp page
+	if err := client.Query(query, &resp); err != nil {
+		return nil, err
+	}
+	records := resp.Records
+	for !resp.Done && resp.NextRecordsUri != "" {
+		next := resp.NextRecordsUri
+		resp = page{}
+		if err := client.QueryNext(next, &resp); err != nil {
+			return nil, err
+		}
+		records = append(records, resp.Records...)
+	}
+	return records, nil
+}
+
+// queryAllToolingRecords runs a Tooling API query following nextRecordsUrl until every page of
+// records has been read, apiVersion is in the REST format with or without the leading v
+func queryAllToolingRecords[T any](client *force.ForceApi, apiVersion, query string) ([]T, error) {
+	type page struct {
+		sobjects.BaseQuery
+		Records []T
+	}
+
+	var resp page
+	path := fmt.Sprintf("/services/data/v%s/tooling/query", strings.TrimPrefix(apiVersion, "v"))
+	if err := client.Get(path, url.Values{"q": {query}}, &resp); err != nil {
+		return nil, err
+	}
+	records := resp.Records
+	for !resp.Done && resp.NextRecordsUri != "" {
+		next := resp.NextRecordsUri
+		resp = page{}
+		if err := client.Get(next, nil, &resp); err != nil {
+			return nil, err
+		}
+		records = append(records, resp.Records...)
+	}
+	return records, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestBindSOQLParameters(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		query   string
+		params  map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "string",
+			query:  "SELECT Id FROM Account WHERE Name = :name",
+			params: map[string]interface{}{"name": "O'Reilly \\ Sons"},
+			want:   `SELECT Id FROM Account WHERE Name = 'O\'Reilly \\ Sons'`,
+		},
+		{
+			name:   "number bool and list",
+			query:  "SELECT Id FROM User WHERE IsActive = :active AND NumberOfEmployees > :min AND ProfileId IN :profiles",
+			params: map[string]interface{}{"active": true, "min": int64(5), "profiles": []interface{}{"a", "b"}},
+			want:   "SELECT Id FROM User WHERE IsActive = true AND NumberOfEmployees > 5 AND ProfileId IN ('a', 'b')",
+		},
+		{
+			name:   "placeholder inside literal is left alone",
+			query:  "SELECT Id FROM Account WHERE Name = ':name' AND Id = :id",
+			params: map[string]interface{}{"id": "001"},
+			want:   "SELECT Id FROM Account WHERE Name = ':name' AND Id = '001'",
+		},
+		{
+			name:   "datetime literal",
+			query:  "SELECT Id FROM Account WHERE CreatedDate > 2020-01-01T00:00:00Z",
+			params: map[string]interface{}{},
+			want:   "SELECT Id FROM Account WHERE CreatedDate > 2020-01-01T00:00:00Z",
+		},
+		{
+			name:    "missing parameter",
+			query:   "SELECT Id FROM Account WHERE Name = :name",
+			params:  map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name:    "unused parameter",
+			query:   "SELECT Id FROM Account",
+			params:  map[string]interface{}{"name": "x"},
+			wantErr: true,
+		},
+		{
+			name:    "empty list",
+			query:   "SELECT Id FROM Account WHERE Id IN :ids",
+			params:  map[string]interface{}{"ids": []interface{}{}},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := bindSOQLParameters(c.query, c.params)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != c.want {
+				t.Fatalf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestSOQLLikeEscape(t *testing.T) {
+	t.Parallel()
+
+	got := soqlLikeEscape(`50%_off's`)
+	want := `50\%\_off\'s`
+	if got != want {
+		t.Errorf("soqlLikeEscape() = %q, want %q", got, want)
+	}
+}
@@ -5,7 +5,14 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
 )
 
 type emptyDescriptions struct {
@@ -24,3 +31,59 @@ var errorNotFoundRegexp = regexp.MustCompile("NOT_FOUND|does not exist")
 func isNotFoundError(err error) bool {
 	return errorNotFoundRegexp.MatchString(err.Error())
 }
+
+// metadataString converts an optional string of a metadata component, which reads back empty when unset
+func metadataString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
+
+func int64Pointer(i int64) *int64 {
+	return &i
+}
+
+// metadataDefault converts a setting that Salesforce reads back as its default when unset,
+// keeping it null if it was null before and still has the default
+func metadataDefault(prior types.String, value, def string) types.String {
+	if prior.IsNull() && (value == def || value == "") {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func metadataBoolDefault(prior types.Bool, value, def bool) types.Bool {
+	if prior.IsNull() && value == def {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
+
+// metadataDiagnostics reports the errors of a component, on the attribute of the component field they name when known
+func metadataDiagnostics(summary string, err error, attributes map[string]path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var resultErr *metadata.ResultError
+	if !errors.As(err, &resultErr) {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+	for _, e := range resultErr.Errors {
+		reported := false
+		for _, field := range e.Fields {
+			if attribute, ok := attributes[field]; ok {
+				diags.AddAttributeError(attribute, summary, e.Message)
+				reported = true
+				break
+			}
+		}
+		if !reported {
+			diags.AddError(summary, fmt.Sprintf("%s: %s", resultErr.FullName, e.Error()))
+		}
+	}
+	return diags
+}
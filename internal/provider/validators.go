@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -98,3 +99,175 @@ func (s stringInSlice) ValidateString(ctx context.Context, req validator.StringR
 		fmt.Sprintf("String must be one of: [%s]", strings.Join(s.slice, ", ")),
 	)
 }
+
+type readOnlySOQL struct{}
+
+func (readOnlySOQL) Description(ctx context.Context) string {
+	return "Ensures the string is a SOQL SELECT statement."
+}
+
+func (readOnlySOQL) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the string is a SOQL SELECT statement."
+}
+
+func (readOnlySOQL) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	if !isReadOnlySOQL(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SOQL query",
+			"Query must be a SOQL SELECT statement.",
+		)
+	}
+}
+
+// customName validates the API name of a custom component, such as Widget__c for a custom object
+type customName struct {
+	suffix string
+}
+
+// a letter, then letters, digits and single underscores, not ending with an underscore
+var customNameRegexp = regexp.MustCompile("^[A-Za-z](_?[A-Za-z0-9])*$")
+
+func (v customName) Description(ctx context.Context) string {
+	if v.suffix == "" {
+		return "Ensures the string is a valid API name."
+	}
+	return fmt.Sprintf("Ensures the string is a custom API name ending with %s.", v.suffix)
+}
+
+func (v customName) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v customName) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	if err := checkCustomName(req.ConfigValue.ValueString(), v.suffix); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid API name", err.Error())
+	}
+}
+
+func checkCustomName(name, suffix string) error {
+	base, ok := strings.CutSuffix(name, suffix)
+	if !ok {
+		return fmt.Errorf("%q must end with %s.", name, suffix)
+	}
+	if !customNameRegexp.MatchString(base) {
+		end := "not end with an underscore"
+		if suffix != "" {
+			end = "not have an underscore before " + suffix
+		}
+		return fmt.Errorf("%q must begin with a letter, contain only letters, digits and single underscores, and %s.", name, end)
+	}
+	return nil
+}
+
+// siteURL validates the URL of a remote or trusted site, a scheme and host without a path
+type siteURL struct {
+	schemes []string
+	// wildcard allows a leading *. in the host
+	wildcard bool
+	// paths allows a path after the host, such as the endpoint of a named credential
+	paths bool
+}
+
+func (v siteURL) Description(ctx context.Context) string {
+	if v.paths {
+		return fmt.Sprintf("Ensures the string is a %s URL of a site.", strings.Join(v.schemes, ", "))
+	}
+	return fmt.Sprintf("Ensures the string is a %s URL of a site, without a path.", strings.Join(v.schemes, ", "))
+}
+
+func (v siteURL) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v siteURL) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	if err := checkSiteURL(req.ConfigValue.ValueString(), v.schemes, v.wildcard, v.paths); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", err.Error())
+	}
+}
+
+func checkSiteURL(s string, schemes []string, wildcard, paths bool) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("%q is not a URL: %v", s, err)
+	}
+	found := false
+	for _, scheme := range schemes {
+		found = found || u.Scheme == scheme
+	}
+	if !found {
+		return fmt.Errorf("%q must begin with %s://.", s, strings.Join(schemes, ":// or "))
+	}
+	host := u.Hostname()
+	if wildcard {
+		host = strings.TrimPrefix(host, "*.")
+	}
+	if host == "" || strings.Contains(host, "*") {
+		return fmt.Errorf("%q must have a host.", s)
+	}
+	if paths && u.Path != "" {
+		u.Path = ""
+	}
+	if u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" || strings.HasSuffix(s, "?") || strings.HasSuffix(s, "#") {
+		if paths {
+			return fmt.Errorf("%q must only have a scheme, a host and optionally a port and a path.", s)
+		}
+		return fmt.Errorf("%q must only have a scheme, a host and optionally a port.", s)
+	}
+	return nil
+}
+
+// levenshtein returns the number of single character edits needed to turn a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// closestString returns the option nearest to s ignoring case, or "" when none is close enough to be a typo
+func closestString(s string, options []string) string {
+	best, bestDistance := "", -1
+	for _, option := range options {
+		distance := levenshtein(strings.ToLower(s), strings.ToLower(option))
+		if bestDistance == -1 || distance < bestDistance {
+			best, bestDistance = option, distance
+		}
+	}
+	// allow roughly one edit per three characters so unrelated values aren't suggested
+	if bestDistance == -1 || bestDistance > len(s)/3+1 {
+		return ""
+	}
+	return best
+}
+
+// didYouMean formats a suggestion for an unrecognized value, empty when there is nothing close
+func didYouMean(s string, options []string) string {
+	if suggestion := closestString(s, options); suggestion != "" {
+		return fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	return ""
+}
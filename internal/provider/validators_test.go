@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-salesforce/internal/picklists"
+)
+
+func TestClosestString(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{"SFCD", "SFDC"},
+		{"aul", "AUL"},
+		{"PID_Customer_Comunity", "PID_Customer_Community"},
+		{"PID_Customer_Community_Pluss", "PID_Customer_Community_Plus"},
+		{"Completely_Unrelated_Value", ""},
+	}
+	for _, c := range cases {
+		if got := closestString(c.value, picklists.LicenseDefinitionKeys); got != c.want {
+			t.Errorf("closestString(%q) = %q, want %q", c.value, got, c.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"SFDC", "SFCD", 2},
+	}
+	for _, c := range cases {
+		if got := levenshtein(c.a, c.b); got != c.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestCheckCustomName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		valid bool
+	}{
+		{"Widget__c", true},
+		{"Widget_Part2__c", true},
+		{"W__c", true},
+		{"Widget", false},
+		{"Widget__mdt", false},
+		{"__c", false},
+		{"2Widget__c", false},
+		{"Widget__Part__c", false},
+		{"Widget___c", false},
+		{"Widget Part__c", false},
+	}
+	for _, c := range cases {
+		if err := checkCustomName(c.name, "__c"); (err == nil) != c.valid {
+			t.Errorf("checkCustomName(%q) = %v, want valid %v", c.name, err, c.valid)
+		}
+	}
+
+	for name, valid := range map[string]bool{"Amount_Positive": true, "Amount_": false, "Amount__Positive": false} {
+		if err := checkCustomName(name, ""); (err == nil) != valid {
+			t.Errorf("checkCustomName(%q) = %v, want valid %v", name, err, valid)
+		}
+	}
+}
+
+func TestCheckSiteURL(t *testing.T) {
+	t.Parallel()
+
+	https := []string{"https", "http"}
+	cases := []struct {
+		url      string
+		wildcard bool
+		paths    bool
+		valid    bool
+	}{
+		{"https://api.example.com", false, false, true},
+		{"http://api.example.com:8080", false, false, true},
+		{"https://*.example.com", true, false, true},
+		{"https://*.example.com", false, false, false},
+		{"https://*", true, false, false},
+		{"ftp://api.example.com", false, false, false},
+		{"api.example.com", false, false, false},
+		{"https://", false, false, false},
+		{"https://api.example.com/", false, false, false},
+		{"https://api.example.com/v1", false, false, false},
+		{"https://api.example.com/v1", false, true, true},
+		{"https://api.example.com?q=1", false, false, false},
+		{"https://api.example.com/v1?q=1", false, true, false},
+		{"https://user@api.example.com", false, false, false},
+	}
+	for _, c := range cases {
+		if err := checkSiteURL(c.url, https, c.wildcard, c.paths); (err == nil) != c.valid {
+			t.Errorf("checkSiteURL(%q, %v, %v) = %v, want valid %v", c.url, c.wildcard, c.paths, err, c.valid)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-salesforce/internal/metadata"
+	"github.com/nimajalali/go-force/force"
+)
+
+// the value set attributes shared by salesforce_global_value_set and salesforce_standard_value_set
+func valueSetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"values": schema.ListNestedAttribute{
+			Description: "Values of the set, in display order. A value removed from the list is deactivated rather than deleted, so records keep it.",
+			Required:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"value": schema.StringAttribute{
+						Description: "API name of the value, stored on records.",
+						Required:    true,
+						Validators: []validator.String{
+							notEmptyString{},
+						},
+					},
+					"label": schema.StringAttribute{
+						Description: "Label of the value in the user interface, defaults to the value.",
+						Optional:    true,
+					},
+					"active": schema.BoolAttribute{
+						Description: "Whether the value can be selected, defaults to true.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"default_value": schema.StringAttribute{
+			Description: "Value selected by default on new records, it must be one of the active values.",
+			Optional:    true,
+		},
+		"sorted": schema.BoolAttribute{
+			Description: "Whether the values are displayed alphabetically instead of in order. Defaults to false.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				booleanNilIsFalse{},
+			},
+		},
+		"inactive_values": schema.ListAttribute{
+			Description: "Inactive values of the set that aren't in values, including the values removed from it.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+type valueSetValueModel struct {
+	Value  types.String `tfsdk:"value"`
+	Label  types.String `tfsdk:"label"`
+	Active types.Bool   `tfsdk:"active"`
+}
+
+// validateValueSetValues checks for duplicate values and that the default is an active value
+func validateValueSetValues(values []valueSetValueModel, defaultValue types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := make(map[string]bool)
+	defaultFound := defaultValue.IsNull() || defaultValue.IsUnknown()
+	for i, v := range values {
+		if v.Value.IsUnknown() {
+			defaultFound = true
+			continue
+		}
+		// the API names of values are case insensitive
+		key := strings.ToLower(v.Value.ValueString())
+		if seen[key] {
+			diags.AddAttributeError(path.Root("values").AtListIndex(i), "Duplicate value", fmt.Sprintf("%q is listed more than once.", v.Value.ValueString()))
+		}
+		seen[key] = true
+		if v.Value.ValueString() == defaultValue.ValueString() {
+			defaultFound = true
+			if !v.Active.IsNull() && !v.Active.IsUnknown() && !v.Active.ValueBool() {
+				diags.AddAttributeError(path.Root("default_value"), "Invalid default value", fmt.Sprintf("%q is inactive.", defaultValue.ValueString()))
+			}
+		}
+	}
+	if !defaultFound {
+		diags.AddAttributeError(path.Root("default_value"), "Invalid default value", fmt.Sprintf("%q is not one of the values.", defaultValue.ValueString()))
+	}
+	return diags
+}
+
+// expandValueSetValues lists the configured values in order, followed by the current values that were removed, deactivated
+func expandValueSetValues(values []valueSetValueModel, defaultValue types.String, current []metadata.CustomValue) []metadata.CustomValue {
+	configured := make(map[string]bool)
+	var out []metadata.CustomValue
+	for _, v := range values {
+		label := v.Label.ValueString()
+		if v.Label.IsNull() {
+			label = v.Value.ValueString()
+		}
+		configured[v.Value.ValueString()] = true
+		out = append(out, metadata.CustomValue{
+			FullName: v.Value.ValueString(),
+			Label:    label,
+			Default:  v.Value.ValueString() == defaultValue.ValueString(),
+			IsActive: boolPointer(v.Active.IsNull() || v.Active.ValueBool()),
+		})
+	}
+	for _, v := range current {
+		if configured[v.FullName] {
+			continue
+		}
+		v.Default = false
+		v.IsActive = boolPointer(false)
+		out = append(out, v)
+	}
+	return out
+}
+
+// flattenValueSetValues reads the values in prior, and the active values that aren't, returning the other values as inactive
+func flattenValueSetValues(prior []valueSetValueModel, current []metadata.CustomValue) (values []valueSetValueModel, inactive []string, defaultValue types.String) {
+	priorValues := make(map[string]valueSetValueModel)
+	for _, v := range prior {
+		priorValues[v.Value.ValueString()] = v
+	}
+	values = []valueSetValueModel{}
+	inactive = []string{}
+	defaultValue = types.StringNull()
+	for _, v := range current {
+		priorValue, ok := priorValues[v.FullName]
+		if !ok && !v.Active() {
+			inactive = append(inactive, v.FullName)
+			continue
+		}
+		if !ok {
+			priorValue = valueSetValueModel{Label: types.StringNull(), Active: types.BoolNull()}
+		}
+		values = append(values, valueSetValueModel{
+			Value:  types.StringValue(v.FullName),
+			Label:  metadataDefault(priorValue.Label, v.Label, v.FullName),
+			Active: metadataBoolDefault(priorValue.Active, v.Active(), true),
+		})
+		if v.Default {
+			defaultValue = types.StringValue(v.FullName)
+		}
+	}
+	return values, inactive, defaultValue
+}
+
+// inactiveValueSetValues returns the values deactivated by expandValueSetValues that aren't configured
+func inactiveValueSetValues(values []valueSetValueModel, expanded []metadata.CustomValue) types.List {
+	configured := make(map[string]bool)
+	for _, v := range values {
+		configured[v.Value.ValueString()] = true
+	}
+	inactive := []attr.Value{}
+	for _, v := range expanded {
+		if !configured[v.FullName] && !v.Active() {
+			inactive = append(inactive, types.StringValue(v.FullName))
+		}
+	}
+	return types.ListValueMust(types.StringType, inactive)
+}
+
+// removedValueSetValues returns the active values of state that plan removes or deactivates
+func removedValueSetValues(state, plan []valueSetValueModel) []string {
+	planned := make(map[string]bool)
+	for _, v := range plan {
+		if v.Value.IsUnknown() {
+			// can't tell what is removed yet
+			return nil
+		}
+		planned[v.Value.ValueString()] = v.Active.IsNull() || v.Active.IsUnknown() || v.Active.ValueBool()
+	}
+	var removed []string
+	for _, v := range state {
+		if v.Active.IsNull() || v.Active.ValueBool() {
+			if !planned[v.Value.ValueString()] {
+				removed = append(removed, v.Value.ValueString())
+			}
+		}
+	}
+	return removed
+}
+
+// componentDependency is a MetadataComponentDependency returned by a Tooling API query
+type componentDependency struct {
+	MetadataComponentId string `json:"MetadataComponentId"`
+}
+
+// customFieldDefinition is a CustomField returned by a Tooling API query, TableEnumOrId is the
+// name of a standard object or the ID of a custom object
+type customFieldDefinition struct {
+	DeveloperName   string  `json:"DeveloperName"`
+	NamespacePrefix *string `json:"NamespacePrefix"`
+	TableEnumOrId   string  `json:"TableEnumOrId"`
+}
+
+// customObjectDefinition is a CustomObject returned by a Tooling API query
+type customObjectDefinition struct {
+	Id              string  `json:"Id"`
+	DeveloperName   string  `json:"DeveloperName"`
+	NamespacePrefix *string `json:"NamespacePrefix"`
+}
+
+// namespacedName prefixes name with the namespace of managed components
+func namespacedName(namespace *string, name string) string {
+	if namespace == nil || *namespace == "" {
+		return name
+	}
+	return *namespace + "__" + name
+}
+
+// globalValueSetFields finds the picklist fields using the global value set through the
+// dependencies of the Tooling API, in the format Object.Field__c
+func globalValueSetFields(client *force.ForceApi, apiVersion, name string) ([]string, error) {
+	dependencies, err := queryAllToolingRecords[componentDependency](client, apiVersion, force.BuildQuery(
+		"MetadataComponentId",
+		"MetadataComponentDependency",
+		[]string{
+			"MetadataComponentType = 'CustomField'",
+			"RefMetadataComponentType = 'GlobalValueSet'",
+			fmt.Sprintf("RefMetadataComponentName = '%s'", soqlEscape(name)),
+		},
+	))
+	if err != nil || len(dependencies) == 0 {
+		return nil, err
+	}
+	ids := make([]string, len(dependencies))
+	for i, d := range dependencies {
+		ids[i] = d.MetadataComponentId
+	}
+	idList, _ := soqlLiteral(ids)
+	fields, err := queryAllToolingRecords[customFieldDefinition](client, apiVersion, force.BuildQuery(
+		"DeveloperName, NamespacePrefix, TableEnumOrId",
+		"CustomField",
+		[]string{"Id IN " + idList},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// custom objects are referenced by ID, look their API names up
+	objects := make(map[string]string)
+	var objectIds []string
+	for _, f := range fields {
+		if strings.HasPrefix(f.TableEnumOrId, "01I") && !contains(objectIds, f.TableEnumOrId) {
+			objectIds = append(objectIds, f.TableEnumOrId)
+		}
+	}
+	if len(objectIds) > 0 {
+		objectList, _ := soqlLiteral(objectIds)
+		customObjects, err := queryAllToolingRecords[customObjectDefinition](client, apiVersion, force.BuildQuery(
+			"Id, DeveloperName, NamespacePrefix",
+			"CustomObject",
+			[]string{"Id IN " + objectList},
+		))
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range customObjects {
+			objects[normalizeId(o.Id)] = namespacedName(o.NamespacePrefix, o.DeveloperName) + "__c"
+		}
+	}
+
+	var names []string
+	for _, f := range fields {
+		object := f.TableEnumOrId
+		if strings.HasPrefix(object, "01I") {
+			if object = objects[normalizeId(object)]; object == "" {
+				continue
+			}
+		}
+		names = append(names, object+"."+namespacedName(f.NamespacePrefix, f.DeveloperName)+"__c")
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+type picklistUsage struct {
+	Value string `force:"value"`
+	Total int    `force:"total"`
+}
+
+// valueSetUsageWarnings warns about removed values that records of the picklist fields, in the format Object.Field, still have
+func valueSetUsageWarnings(client *force.ForceApi, fields []string, removed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || len(removed) == 0 {
+		return diags
+	}
+	values, _ := soqlLiteral(removed)
+	for _, field := range fields {
+		object, name, ok := strings.Cut(field, ".")
+		if !ok {
+			continue
+		}
+		query := fmt.Sprintf("SELECT %s value, COUNT(Id) total FROM %s WHERE %s IN %s GROUP BY %s", name, object, name, values, name)
+		usage, err := queryAllRecords[picklistUsage](client, query)
+		if err != nil {
+			diags.AddWarning("Unable to check value usage", fmt.Sprintf("Couldn't count the records of %s using the removed values: %s", field, err))
+			continue
+		}
+		sort.Slice(usage, func(i, j int) bool { return usage[i].Value < usage[j].Value })
+		for _, u := range usage {
+			diags.AddAttributeWarning(
+				path.Root("values"),
+				"Removed value still in use",
+				fmt.Sprintf("%d %s records have %s set to %q, the value will be deactivated and kept on them.", u.Total, object, name, u.Value),
+			)
+		}
+	}
+	return diags
+}